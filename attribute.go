@@ -0,0 +1,43 @@
+package woocommerce
+
+// AttributeOrderBy is the default sort order of terms of an attribute in the store.
+type AttributeOrderBy string
+
+const (
+	// AttributeOrderByMenuOrder sorts terms by their MenuOrder, which is the custom ordering of the store.
+	AttributeOrderByMenuOrder AttributeOrderBy = "menu_order"
+	AttributeOrderByName      AttributeOrderBy = "name"
+	// AttributeOrderByNameNum sorts terms by their names numerically.
+	AttributeOrderByNameNum AttributeOrderBy = "name_num"
+	AttributeOrderByID      AttributeOrderBy = "id"
+)
+
+// Attribute is a global product attribute, such as pa_color, whose terms are shared by all products.
+// It is different from the ProductAttribute type, which is an attribute assigned to a single product.
+//
+// Fields with zero values are omitted when the attribute is created or updated,
+// so only fields that are set are changed.
+type Attribute struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Slug is the name of the taxonomy of the attribute. Woocommerce prefixes it with pa_.
+	Slug string `json:"slug,omitempty"`
+	// Type is select by default. Extensions can add other types.
+	Type    string           `json:"type,omitempty"`
+	OrderBy AttributeOrderBy `json:"order_by,omitempty"`
+	// HasArchives enables archive pages of terms of the attribute. It is a pointer,
+	// so that archives can be disabled by an update, while nil keeps the current setting.
+	HasArchives *bool `json:"has_archives,omitempty"`
+}
+
+// AttributeTerm is a term of a global product attribute, for instance the color blue of the pa_color attribute.
+type AttributeTerm struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	// MenuOrder is the position of the term when terms are sorted by AttributeOrderByMenuOrder.
+	MenuOrder int `json:"menu_order,omitempty"`
+	// Count is the number of products with the term.
+	Count int `json:"count,omitempty"`
+}
@@ -0,0 +1,35 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// APIType is the type of the API to call. Woocommerce has two APIs:
+// - [REST API](https://woocommerce.github.io/woocommerce-rest-api-docs/)
+// - [Blocks API](https://github.com/woocommerce/woocommerce-blocks/tree/trunk/src/StoreApi)
+type APIType string
+
+const (
+	APITypeRest   APIType = "rest"
+	APITypeBlocks APIType = "blocks"
+)
+
+// Backend executes requests to the woocommerce server. All clients depend on this interface,
+// so it can be replaced with a mock in tests. The default implementation is created by client.New.
+type Backend interface {
+	// AuthenticatedRequestContext executes an authenticated request to the woocommerce server.
+	// Path is relative to the index of the API, for instance /orders.
+	// Body is marshalled to JSON and can be nil. Parameters and headers can be nil as well.
+	// Responses with status code not in range of [200, 300) must be returned as an error,
+	// *Error for status codes above 400.
+	// If the error is nil, caller is responsible for closing the response body.
+	AuthenticatedRequestContext(ctx context.Context, apiType APIType, method, path string, body interface{}, parameters Parameters, headers map[string]string) (*http.Response, error)
+}
+
+// BackendFunc is an adapter that allows the use of ordinary functions as Backend.
+type BackendFunc func(ctx context.Context, apiType APIType, method, path string, body interface{}, parameters Parameters, headers map[string]string) (*http.Response, error)
+
+func (f BackendFunc) AuthenticatedRequestContext(ctx context.Context, apiType APIType, method, path string, body interface{}, parameters Parameters, headers map[string]string) (*http.Response, error) {
+	return f(ctx, apiType, method, path, body, parameters, headers)
+}
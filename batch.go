@@ -0,0 +1,192 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MaxBatchSize is the maximum number of objects that woocommerce accepts in a single batch request.
+// Batch methods of clients split larger batches into multiple requests.
+const MaxBatchSize = 100
+
+// BatchOperation is the operation of a batch request.
+type BatchOperation string
+
+const (
+	BatchOperationCreate BatchOperation = "create"
+	BatchOperationUpdate BatchOperation = "update"
+	BatchOperationDelete BatchOperation = "delete"
+)
+
+// BatchRequest creates, updates and deletes multiple objects at once.
+// C is the type of created objects and U is the type of updates.
+type BatchRequest[C, U any] struct {
+	Create []C              `json:"create,omitempty"`
+	Update []BatchUpdate[U] `json:"update,omitempty"`
+	// Delete holds IDs of objects to delete.
+	Delete []int `json:"delete,omitempty"`
+}
+
+// Len returns the number of objects in the batch.
+func (r BatchRequest[C, U]) Len() int {
+	return len(r.Create) + len(r.Update) + len(r.Delete)
+}
+
+// Chunks splits the batch into batches with at most size objects each.
+// Creates are placed first, followed by updates and deletes, so the order of objects is kept.
+func (r BatchRequest[C, U]) Chunks(size int) []BatchRequest[C, U] {
+	var chunks []BatchRequest[C, U]
+	var chunk BatchRequest[C, U]
+	n := 0
+	flush := func() {
+		if n == size {
+			chunks = append(chunks, chunk)
+			chunk = BatchRequest[C, U]{}
+			n = 0
+		}
+	}
+
+	for _, c := range r.Create {
+		chunk.Create = append(chunk.Create, c)
+		n++
+		flush()
+	}
+	for _, u := range r.Update {
+		chunk.Update = append(chunk.Update, u)
+		n++
+		flush()
+	}
+	for _, id := range r.Delete {
+		chunk.Delete = append(chunk.Delete, id)
+		n++
+		flush()
+	}
+	if n > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// BatchUpdate is an update of the object with the given ID in a batch request.
+// The ID is added to the JSON object of the update.
+type BatchUpdate[U any] struct {
+	ID     int
+	Update U
+}
+
+func (u BatchUpdate[U]) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(u.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if string(data) != "null" {
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("[woocommerce-go]: batch update must marshal to a JSON object: %w", err)
+		}
+	}
+	fields["id"] = json.RawMessage(fmt.Sprint(u.ID))
+
+	return json.Marshal(fields)
+}
+
+// BatchItem is the result of a single object of a batch request.
+// If woocommerce could not process the object, Error is set and Object is the zero value.
+type BatchItem[T any] struct {
+	// ID is the ID of the object. It is 0 for objects that could not be created.
+	ID     int
+	Object T
+	Error  *Error
+}
+
+func (i *BatchItem[T]) UnmarshalJSON(bytes []byte) error {
+	var item struct {
+		ID    int    `json:"id"`
+		Error *Error `json:"error"`
+	}
+	if err := json.Unmarshal(bytes, &item); err != nil {
+		return err
+	}
+
+	i.ID = item.ID
+	if item.Error != nil {
+		i.Error = item.Error
+		i.Error.StatusCode = i.Error.Data.Status
+		return nil
+	}
+	return json.Unmarshal(bytes, &i.Object)
+}
+
+// BatchResponse is the result of a batch request. Results are in the same order as objects of the request.
+type BatchResponse[T any] struct {
+	Create []BatchItem[T] `json:"create"`
+	Update []BatchItem[T] `json:"update"`
+	Delete []BatchItem[T] `json:"delete"`
+}
+
+// Append appends results of another response, for instance of the next chunk of the batch.
+func (r *BatchResponse[T]) Append(other *BatchResponse[T]) {
+	r.Create = append(r.Create, other.Create...)
+	r.Update = append(r.Update, other.Update...)
+	r.Delete = append(r.Delete, other.Delete...)
+}
+
+// Err returns a *BatchError with all objects that woocommerce could not process, or nil if all succeeded.
+func (r *BatchResponse[T]) Err() error {
+	var batchErr BatchError
+	results := []struct {
+		operation BatchOperation
+		items     []BatchItem[T]
+	}{
+		{BatchOperationCreate, r.Create},
+		{BatchOperationUpdate, r.Update},
+		{BatchOperationDelete, r.Delete},
+	}
+	for _, result := range results {
+		for i, item := range result.items {
+			if item.Error != nil {
+				batchErr.Items = append(batchErr.Items, BatchItemError{
+					Operation: result.operation,
+					Index:     i,
+					ID:        item.ID,
+					Err:       item.Error,
+				})
+			}
+		}
+	}
+
+	if len(batchErr.Items) == 0 {
+		return nil
+	}
+	return &batchErr
+}
+
+// BatchItemError is the error of a single object of a batch request.
+type BatchItemError struct {
+	Operation BatchOperation
+	// Index is the index of the object in the list of the operation in the request.
+	Index int
+	// ID is the ID of the object. It is 0 for objects that could not be created.
+	ID  int
+	Err *Error
+}
+
+func (e BatchItemError) Error() string {
+	return fmt.Sprintf("%s #%d (id %d): %s", e.Operation, e.Index, e.ID, e.Err.Message)
+}
+
+// BatchError holds errors of objects that woocommerce could not process in a batch request.
+type BatchError struct {
+	Items []BatchItemError
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, len(e.Items))
+	for i, item := range e.Items {
+		messages[i] = item.Error()
+	}
+	return fmt.Sprintf("[woocommerce-go]: %d batch items failed: %s", len(e.Items), strings.Join(messages, "; "))
+}
@@ -0,0 +1,84 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBatchRequest_Chunks(t *testing.T) {
+	request := BatchRequest[int, int]{
+		Create: make([]int, 150),
+		Update: make([]BatchUpdate[int], 30),
+		Delete: make([]int, 40),
+	}
+
+	chunks := request.Chunks(MaxBatchSize)
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+
+	expected := []struct{ create, update, delete int }{
+		{100, 0, 0},
+		{50, 30, 20},
+		{0, 0, 20},
+	}
+	for i, e := range expected {
+		c := chunks[i]
+		if len(c.Create) != e.create || len(c.Update) != e.update || len(c.Delete) != e.delete {
+			t.Errorf("chunk %d: expected %v, got %d/%d/%d", i, e, len(c.Create), len(c.Update), len(c.Delete))
+		}
+	}
+
+	if chunks := (BatchRequest[int, int]{}).Chunks(MaxBatchSize); len(chunks) != 0 {
+		t.Errorf("expected no chunks for an empty batch, got %d", len(chunks))
+	}
+}
+
+func TestBatchUpdate_MarshalJSON(t *testing.T) {
+	update := BatchUpdate[OrderUpdate]{ID: 5, Update: OrderUpdate{Status: Ptr(OrderStatusCompleted)}}
+	b, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"id":5,"status":"completed"}`; string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+
+	pointer := BatchUpdate[*OrderUpdate]{ID: 6}
+	b, err = json.Marshal(pointer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"id":6}`; string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestBatchResponse_Err(t *testing.T) {
+	data := `{
+		"create": [{"id": 10, "country": "SI"}, {"id": 0, "error": {"code": "woocommerce_rest_invalid_rate", "message": "Invalid rate.", "data": {"status": 400}}}],
+		"delete": [{"id": 99, "error": {"code": "woocommerce_rest_invalid_id", "message": "Invalid ID.", "data": {"status": 404}}}]
+	}`
+
+	var response BatchResponse[*Tax]
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Create[0].Object == nil || response.Create[0].Object.Country != "SI" || response.Create[0].Error != nil {
+		t.Errorf("unexpected successful item: %+v", response.Create[0])
+	}
+
+	var batchErr *BatchError
+	if err := response.Err(); !errors.As(err, &batchErr) {
+		t.Fatalf("expected batch error, got %v", err)
+	}
+	if len(batchErr.Items) != 2 {
+		t.Fatalf("expected 2 failed items, got %d", len(batchErr.Items))
+	}
+
+	item := batchErr.Items[1]
+	if item.Operation != BatchOperationDelete || item.Index != 0 || item.ID != 99 || item.Err.StatusCode != 404 {
+		t.Errorf("unexpected failed item: %+v", item)
+	}
+}
@@ -1,6 +1,7 @@
 package cart
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,13 +30,13 @@ const (
 // Client is the API client used for working with cart.
 // It should not be initialized directly. Use client.API instead.
 type Client struct {
-	backend *backend.Backend
+	backend woocommerce.Backend
 }
 
 // New creates a new client for cart.
 // It should not be called directly.
 // Instead, client.API should be used.
-func New(backend *backend.Backend) *Client {
+func New(backend woocommerce.Backend) *Client {
 	return &Client{
 		backend: backend,
 	}
@@ -43,11 +44,16 @@ func New(backend *backend.Backend) *Client {
 
 // Get gets the cart with given cart token.
 func (c Client) Get(cartToken string) (*woocommerce.Cart, error) {
+	return c.GetContext(context.Background(), cartToken)
+}
+
+// GetContext is the same as Get, but it uses the given context for the request.
+func (c Client) GetContext(ctx context.Context, cartToken string) (*woocommerce.Cart, error) {
 	// Execute request
 	headers := map[string]string{
 		headerCartToken: cartToken,
 	}
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +73,12 @@ func (c Client) Get(cartToken string) (*woocommerce.Cart, error) {
 
 // New creates a new cart. It returns the cart token, an error that might have occurred.
 func (c Client) New() (token string, err error) {
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, nil)
+	return c.NewContext(context.Background())
+}
+
+// NewContext is the same as New, but it uses the given context for the request.
+func (c Client) NewContext(ctx context.Context) (token string, err error) {
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, nil)
 	if err != nil {
 		return "", err
 	}
@@ -78,12 +89,12 @@ func (c Client) New() (token string, err error) {
 }
 
 // getNonce gets the nonce for the cart with given cart token.
-func (c Client) getNonce(cartToken string) (string, error) {
+func (c Client) getNonce(ctx context.Context, cartToken string) (string, error) {
 	// Execute request
 	headers := map[string]string{
 		headerCartToken: cartToken,
 	}
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodGet, pathCart, nil, nil, headers)
 	if err != nil {
 		return "", err
 	}
@@ -94,8 +105,13 @@ func (c Client) getNonce(cartToken string) (string, error) {
 
 // AddItem adds an item to the cart with given cart token.
 func (c Client) AddItem(cartToken string, itemID, quantity int, variations []woocommerce.CartItemVariation) (*woocommerce.Cart, error) {
+	return c.AddItemContext(context.Background(), cartToken, itemID, quantity, variations)
+}
+
+// AddItemContext is the same as AddItem, but it uses the given context for the request.
+func (c Client) AddItemContext(ctx context.Context, cartToken string, itemID, quantity int, variations []woocommerce.CartItemVariation) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -119,7 +135,7 @@ func (c Client) AddItem(cartToken string, itemID, quantity int, variations []woo
 	}
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, pathAddItem, req, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, pathAddItem, req, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +155,13 @@ func (c Client) AddItem(cartToken string, itemID, quantity int, variations []woo
 
 // RemoveItem removes an item from the cart.
 func (c Client) RemoveItem(cartToken string, itemKey string) (*woocommerce.Cart, error) {
+	return c.RemoveItemContext(context.Background(), cartToken, itemKey)
+}
+
+// RemoveItemContext is the same as RemoveItem, but it uses the given context for the request.
+func (c Client) RemoveItemContext(ctx context.Context, cartToken string, itemKey string) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -160,7 +181,7 @@ func (c Client) RemoveItem(cartToken string, itemKey string) (*woocommerce.Cart,
 	}
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, pathRemoveItem, req, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, pathRemoveItem, req, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +201,13 @@ func (c Client) RemoveItem(cartToken string, itemKey string) (*woocommerce.Cart,
 
 // UpdateItem updates the quantity of an item in the cart.
 func (c Client) UpdateItem(cartToken, itemKey string, quantity int) (*woocommerce.Cart, error) {
+	return c.UpdateItemContext(context.Background(), cartToken, itemKey, quantity)
+}
+
+// UpdateItemContext is the same as UpdateItem, but it uses the given context for the request.
+func (c Client) UpdateItemContext(ctx context.Context, cartToken, itemKey string, quantity int) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -203,7 +229,7 @@ func (c Client) UpdateItem(cartToken, itemKey string, quantity int) (*woocommerc
 	}
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, pathUpdateItem, req, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, pathUpdateItem, req, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -223,8 +249,13 @@ func (c Client) UpdateItem(cartToken, itemKey string, quantity int) (*woocommerc
 
 // UpdateCustomer updates the customer shipping and billing address.
 func (c Client) UpdateCustomer(cartToken string, billingAddress, shippingAddress *woocommerce.CartAddress) (*woocommerce.Cart, error) {
+	return c.UpdateCustomerContext(context.Background(), cartToken, billingAddress, shippingAddress)
+}
+
+// UpdateCustomerContext is the same as UpdateCustomer, but it uses the given context for the request.
+func (c Client) UpdateCustomerContext(ctx context.Context, cartToken string, billingAddress, shippingAddress *woocommerce.CartAddress) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -246,7 +277,7 @@ func (c Client) UpdateCustomer(cartToken string, billingAddress, shippingAddress
 	}
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, pathUpdateCustomer, req, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, pathUpdateCustomer, req, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +297,13 @@ func (c Client) UpdateCustomer(cartToken string, billingAddress, shippingAddress
 
 // SelectShippingRate selects a shipping rate for the cart.
 func (c Client) SelectShippingRate(cartToken string, packageID int, rateID string) (*woocommerce.Cart, error) {
+	return c.SelectShippingRateContext(context.Background(), cartToken, packageID, rateID)
+}
+
+// SelectShippingRateContext is the same as SelectShippingRate, but it uses the given context for the request.
+func (c Client) SelectShippingRateContext(ctx context.Context, cartToken string, packageID int, rateID string) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -289,7 +325,7 @@ func (c Client) SelectShippingRate(cartToken string, packageID int, rateID strin
 	}
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, pathSelectShippingRate, req, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, pathSelectShippingRate, req, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -308,9 +344,9 @@ func (c Client) SelectShippingRate(cartToken string, packageID int, rateID strin
 }
 
 // executeCartCouponRequest executes a request to modify the cart with a coupon.
-func (c Client) executeCartCouponRequest(cartToken, couponCode, path string) (*woocommerce.Cart, error) {
+func (c Client) executeCartCouponRequest(ctx context.Context, cartToken, couponCode, path string) (*woocommerce.Cart, error) {
 	// Get nonce for the cart.
-	nonce, err := c.getNonce(cartToken)
+	nonce, err := c.getNonce(ctx, cartToken)
 	if err != nil {
 		return nil, fmt.Errorf("[woocommerce-go] could not get nonce: %w", err)
 	}
@@ -327,7 +363,7 @@ func (c Client) executeCartCouponRequest(cartToken, couponCode, path string) (*w
 	url := fmt.Sprintf("%s?%s", path, params.Encode())
 
 	// Execute request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeBlocks, http.MethodPost, url, nil, nil, headers)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeBlocks, http.MethodPost, url, nil, nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -347,10 +383,20 @@ func (c Client) executeCartCouponRequest(cartToken, couponCode, path string) (*w
 
 // ApplyCoupon applies a coupon to the cart.
 func (c Client) ApplyCoupon(cartToken, couponCode string) (*woocommerce.Cart, error) {
-	return c.executeCartCouponRequest(cartToken, couponCode, pathApplyCoupon)
+	return c.ApplyCouponContext(context.Background(), cartToken, couponCode)
+}
+
+// ApplyCouponContext is the same as ApplyCoupon, but it uses the given context for the request.
+func (c Client) ApplyCouponContext(ctx context.Context, cartToken, couponCode string) (*woocommerce.Cart, error) {
+	return c.executeCartCouponRequest(ctx, cartToken, couponCode, pathApplyCoupon)
 }
 
 // RemoveCoupon removes a coupon from the cart.
 func (c Client) RemoveCoupon(cartToken, couponCode string) (*woocommerce.Cart, error) {
-	return c.executeCartCouponRequest(cartToken, couponCode, pathRemoveCoupon)
+	return c.RemoveCouponContext(context.Background(), cartToken, couponCode)
+}
+
+// RemoveCouponContext is the same as RemoveCoupon, but it uses the given context for the request.
+func (c Client) RemoveCouponContext(ctx context.Context, cartToken, couponCode string) (*woocommerce.Cart, error) {
+	return c.executeCartCouponRequest(ctx, cartToken, couponCode, pathRemoveCoupon)
 }
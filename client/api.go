@@ -1,7 +1,9 @@
 package client
 
 import (
+	"github.com/zerodays/woocommerce-go"
 	"github.com/zerodays/woocommerce-go/cart"
+	"github.com/zerodays/woocommerce-go/coupon"
 	"github.com/zerodays/woocommerce-go/customer"
 	"github.com/zerodays/woocommerce-go/internal/backend"
 	"github.com/zerodays/woocommerce-go/order"
@@ -18,26 +20,43 @@ import (
 // - C: Customer, default implementation is woocommerce.Customer
 // - P: Product, default implementation is woocommerce.Product
 // - PV: ProductVariation, default implementation is woocommerce.ProductVariation
+//
+// Product client updates products with the default woocommerce.ProductUpdate and woocommerce.ProductVariationUpdate
+// types. Client with custom update types can be created with product.New, using the backend of the API.
+//
+// Coupon client uses the default woocommerce.ShopCoupon type. Client with a custom coupon type
+// can be created with coupon.New, using the backend of the API.
 type API[C, P, PV any] struct {
 	Order    *order.Client
 	Cart     *cart.Client
 	Tax      *tax.Client
 	Customer *customer.Client[C]
-	Product  *product.Client[P, PV]
+	Product  *product.Client[P, PV, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate]
+	Coupon   *coupon.Client[woocommerce.ShopCoupon]
+
+	backend woocommerce.Backend
 }
 
 // Init initializes the API client with given credentials.
 // ConsumerKey and consumerSecret are gotten from woocommerce admin console.
 // BaseURL is the base URL of the store. For instance if the index URL of the woocommerce API is
 // https://example.com/wp-json/wc/v3, then the base URL is https://example.com
-func (a *API[C, P, PV]) Init(baseURL, consumerKey, consumerSecret string) {
-	b := backend.New(baseURL, consumerKey, consumerSecret)
+// Options configure the HTTP client that is shared by all clients of the API.
+func (a *API[C, P, PV]) Init(baseURL, consumerKey, consumerSecret string, options ...Option) {
+	a.InitWithBackend(backend.New(baseURL, consumerKey, consumerSecret, options...))
+}
+
+// InitWithBackend initializes the API client with the given backend.
+// It can be used to replace the backend with a mock in tests.
+func (a *API[C, P, PV]) InitWithBackend(b woocommerce.Backend) {
+	a.backend = b
 
 	a.Order = order.New(b)
 	a.Cart = cart.New(b)
 	a.Tax = tax.New(b)
 	a.Customer = customer.New[C](b)
-	a.Product = product.New[P, PV](b)
+	a.Product = product.New[P, PV, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](b)
+	a.Coupon = coupon.New[woocommerce.ShopCoupon](b)
 }
 
 // New creates a new API client with given credentials.
@@ -46,8 +65,37 @@ func (a *API[C, P, PV]) Init(baseURL, consumerKey, consumerSecret string) {
 // https://example.com/wp-json/wc/v3, then the base URL is https://example.com
 //
 // Generic parameters are documented in the definition of the API type.
-func New[C, P, PV any](baseURL, consumerKey, consumerSecret string) *API[C, P, PV] {
+// Options configure the HTTP client that is shared by all clients of the API.
+func New[C, P, PV any](baseURL, consumerKey, consumerSecret string, options ...Option) *API[C, P, PV] {
 	api := &API[C, P, PV]{}
-	api.Init(baseURL, consumerKey, consumerSecret)
+	api.Init(baseURL, consumerKey, consumerSecret, options...)
 	return api
 }
+
+// NewWithBackend creates a new API client that executes requests with the given backend.
+// It can be used to replace the backend with a mock in tests.
+//
+// Generic parameters are documented in the definition of the API type.
+func NewWithBackend[C, P, PV any](b woocommerce.Backend) *API[C, P, PV] {
+	api := &API[C, P, PV]{}
+	api.InitWithBackend(b)
+	return api
+}
+
+// Backend returns the backend that is shared by all clients of the API.
+// It can be used to create clients with custom types, for instance with product.New.
+func (a *API[C, P, PV]) Backend() woocommerce.Backend {
+	return a.backend
+}
+
+// LimiterStats returns metrics of the limiter for the given API type.
+// If limits for the API type are not set with WithLimits or the API uses a custom backend,
+// empty stats are returned.
+func (a *API[C, P, PV]) LimiterStats(apiType APIType) LimiterStats {
+	if b, ok := a.backend.(interface {
+		LimiterStats(apiType APIType) LimiterStats
+	}); ok {
+		return b.LimiterStats(apiType)
+	}
+	return LimiterStats{}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// Option configures the API client. Options are passed to the New function.
+type Option = backend.Option
+
+// WithHTTPClient sets the HTTP client that is used for executing requests.
+// The client is not modified. If WithTimeout or WithTransport are also passed,
+// they are applied to a copy of the client.
+func WithHTTPClient(client *http.Client) Option {
+	return backend.WithHTTPClient(client)
+}
+
+// WithTimeout sets the timeout of the HTTP client.
+// Zero timeout means no timeout. Default timeout is one minute.
+func WithTimeout(timeout time.Duration) Option {
+	return backend.WithTimeout(timeout)
+}
+
+// WithTransport sets the transport of the HTTP client. It can be used to
+// configure proxies, custom TLS settings or to stub responses in tests.
+func WithTransport(transport http.RoundTripper) Option {
+	return backend.WithTransport(transport)
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+// By default, the header is sent empty, because go's default one is blocked by some hosting providers.
+func WithUserAgent(userAgent string) Option {
+	return backend.WithUserAgent(userAgent)
+}
+
+// WithBaseHeaders sets headers that are sent with every request.
+// Headers passed to a single request take precedence over base headers.
+// A User-Agent base header takes precedence over WithUserAgent.
+func WithBaseHeaders(headers map[string]string) Option {
+	return backend.WithBaseHeaders(headers)
+}
+
+// RetryPolicy configures retrying of failed requests.
+type RetryPolicy = backend.RetryPolicy
+
+// Attempt describes a single attempt of a request. It is passed to RetryPolicy.OnAttempt.
+type Attempt = backend.Attempt
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults
+// for woocommerce stores on shared hosting.
+func DefaultRetryPolicy() RetryPolicy {
+	return backend.DefaultRetryPolicy()
+}
+
+// WithRetryPolicy sets the policy for retrying failed requests.
+// By default, requests are not retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return backend.WithRetryPolicy(policy)
+}
+
+// APIType is the type of the woocommerce API. Limits are configured per API type.
+type APIType = backend.APIType
+
+const (
+	APITypeRest   = backend.APITypeRest
+	APITypeBlocks = backend.APITypeBlocks
+)
+
+// Limits configures client-side limiting of requests sent to a single API.
+type Limits = backend.Limits
+
+// LimiterStats holds metrics of the limiter of a single API.
+type LimiterStats = backend.LimiterStats
+
+// WithLimits sets limits for requests to the given API type.
+// Limits are shared between all clients of the API.
+func WithLimits(apiType APIType, limits Limits) Option {
+	return backend.WithLimits(apiType, limits)
+}
+
+// Authenticator authenticates requests to the woocommerce REST API.
+type Authenticator = backend.Authenticator
+
+// BasicAuth authenticates requests with HTTP Basic authentication. This is the default.
+type BasicAuth = backend.BasicAuth
+
+// OAuth1 authenticates requests with one-legged OAuth 1.0a.
+type OAuth1 = backend.OAuth1
+
+// QueryStringAuth authenticates requests by passing credentials as query parameters.
+type QueryStringAuth = backend.QueryStringAuth
+
+// SignatureMethod is the method used for signing OAuth 1.0a requests.
+type SignatureMethod = backend.SignatureMethod
+
+const (
+	SignatureMethodHMACSHA1   = backend.SignatureMethodHMACSHA1
+	SignatureMethodHMACSHA256 = backend.SignatureMethodHMACSHA256
+)
+
+// WithAuthenticator sets the authenticator of REST API requests.
+// Credentials passed to New are ignored in that case.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return backend.WithAuthenticator(authenticator)
+}
+
+// WithOAuth1 authenticates REST API requests with one-legged OAuth 1.0a using credentials passed to New.
+// Woocommerce requires it on stores served over plain HTTP.
+func WithOAuth1(signatureMethod SignatureMethod) Option {
+	return backend.WithOAuth1(signatureMethod)
+}
+
+// WithQueryStringAuth authenticates REST API requests by passing credentials passed to New as query parameters.
+func WithQueryStringAuth() Option {
+	return backend.WithQueryStringAuth()
+}
+
+// ApplicationPassword authenticates requests as a WordPress user with an application password.
+type ApplicationPassword = backend.ApplicationPassword
+
+// JWTAuth authenticates requests with a bearer token issued by a WordPress JWT authentication plugin.
+type JWTAuth = backend.JWTAuth
+
+// TokenAuthenticator is an Authenticator whose tokens are refreshed when the server rejects them.
+type TokenAuthenticator = backend.TokenAuthenticator
+
+// NewJWTAuth creates a new JWT authenticator for the WordPress user with given credentials.
+// TokenPath is the path of the token endpoint relative to the base URL of the store. If empty,
+// /wp-json/jwt-auth/v1/token is used.
+func NewJWTAuth(username, password, tokenPath string) *JWTAuth {
+	return backend.NewJWTAuth(username, password, tokenPath)
+}
+
+// WithStoreAuthenticator sets the authenticator of Store API requests, which are not authenticated by default.
+// Authenticating Store API requests as a customer makes the cart act as the cart of a logged-in customer.
+func WithStoreAuthenticator(authenticator Authenticator) Option {
+	return backend.WithStoreAuthenticator(authenticator)
+}
@@ -0,0 +1,221 @@
+package coupon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathList  = "/coupons"
+	pathEdit  = "/coupons/%d"
+	pathBatch = "/coupons/batch"
+)
+
+// Client is the API client used for working with coupons.
+// It should not be initialized directly. Use client.API instead.
+//
+// Generic type CP represents the type of the coupon.
+// This library provides default implementation of the coupon type as woocommerce.ShopCoupon.
+// If you have extensions installed on woocommerce that add additional fields to the coupon,
+// you can create your own type that embeds the woocommerce.ShopCoupon type and add additional fields.
+// Client with a custom type can be created with New, using the backend of the API:
+//
+//	coupons := coupon.New[MyCoupon](api.Backend())
+type Client[CP any] struct {
+	backend woocommerce.Backend
+}
+
+// New creates a new client for coupons.
+// It should not be called directly for the default coupon type.
+// Instead, client.API should be used.
+func New[CP any](backend woocommerce.Backend) *Client[CP] {
+	return &Client[CP]{
+		backend: backend,
+	}
+}
+
+// List returns a list of coupons with given parameters and total coupon count.
+func (c Client[CP]) List(parameters woocommerce.Parameters) ([]CP, int, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c Client[CP]) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]CP, int, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var coupons []CP
+	err = json.NewDecoder(resp.Body).Decode(&coupons)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[woocommerce-go]: could not unmarshal coupons json: %w", err)
+	}
+
+	// Get total coupon count
+	countStr := resp.Header.Get(backend.TotalCountHeader)
+	var count int
+	if countStr != "" {
+		count, err = strconv.Atoi(countStr)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[woocommerce-go]: could not parse total coupon count: %w", err)
+		}
+	}
+
+	return coupons, count, nil
+}
+
+// Pager returns a pager that walks all pages of coupons with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client[CP]) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[CP] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[CP], error) {
+		return backend.ListPage[CP](ctx, c.backend, pathList, parameters)
+	}, options...)
+}
+
+// Retrieve retrieves the coupon with a given ID.
+func (c Client[CP]) Retrieve(couponID int) (CP, error) {
+	return c.RetrieveContext(context.Background(), couponID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c Client[CP]) RetrieveContext(ctx context.Context, couponID int) (CP, error) {
+	var coupon CP
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathEdit, couponID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return coupon, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&coupon)
+	if err != nil {
+		return coupon, fmt.Errorf("[woocommerce-go]: could not unmarshal coupon json: %w", err)
+	}
+
+	return coupon, nil
+}
+
+// RetrieveByCode retrieves the coupon with a given code. Coupon codes are case-insensitive.
+// If there is no coupon with the code, woocommerce.ErrNotFound is returned.
+func (c Client[CP]) RetrieveByCode(code string) (CP, error) {
+	return c.RetrieveByCodeContext(context.Background(), code)
+}
+
+// RetrieveByCodeContext is the same as RetrieveByCode, but it uses the given context for the request.
+func (c Client[CP]) RetrieveByCodeContext(ctx context.Context, code string) (CP, error) {
+	var coupon CP
+
+	parameters := woocommerce.BaseParameters{"code": {code}}
+	coupons, _, err := c.ListContext(ctx, parameters)
+	if err != nil {
+		return coupon, err
+	}
+	if len(coupons) == 0 {
+		return coupon, fmt.Errorf("%w: coupon with code %q", woocommerce.ErrNotFound, code)
+	}
+
+	return coupons[0], nil
+}
+
+// Create creates a new coupon.
+func (c Client[CP]) Create(coupon *CP) (CP, error) {
+	return c.CreateContext(context.Background(), coupon)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c Client[CP]) CreateContext(ctx context.Context, coupon *CP) (CP, error) {
+	var created CP
+
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, pathList, coupon, nil, nil)
+	if err != nil {
+		return created, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return created, fmt.Errorf("[woocommerce-go]: could not unmarshal coupon json: %w", err)
+	}
+
+	return created, nil
+}
+
+// Update updates the coupon with a given ID. Only fields that are set on the update are changed.
+func (c Client[CP]) Update(couponID int, update woocommerce.ShopCouponUpdate) (CP, error) {
+	return c.UpdateContext(context.Background(), couponID, update)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c Client[CP]) UpdateContext(ctx context.Context, couponID int, update woocommerce.ShopCouponUpdate) (CP, error) {
+	var updated CP
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathEdit, couponID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, update, nil, nil)
+	if err != nil {
+		return updated, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+	if err != nil {
+		return updated, fmt.Errorf("[woocommerce-go]: could not unmarshal coupon json: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Delete deletes the coupon with a given ID and returns the deleted coupon.
+// If force is false, the coupon is moved to trash. Otherwise, it is permanently deleted.
+func (c Client[CP]) Delete(couponID int, force bool) (CP, error) {
+	return c.DeleteContext(context.Background(), couponID, force)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c Client[CP]) DeleteContext(ctx context.Context, couponID int, force bool) (CP, error) {
+	var deleted CP
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathEdit, couponID)
+	parameters := woocommerce.BaseParameters{"force": {strconv.FormatBool(force)}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return deleted, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&deleted)
+	if err != nil {
+		return deleted, fmt.Errorf("[woocommerce-go]: could not unmarshal coupon json: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// Batch creates, updates and deletes multiple coupons. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Coupons that could not be processed are reported by the Err method of the response.
+func (c Client[CP]) Batch(request woocommerce.BatchRequest[CP, woocommerce.ShopCouponUpdate]) (*woocommerce.BatchResponse[CP], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c Client[CP]) BatchContext(ctx context.Context, request woocommerce.BatchRequest[CP, woocommerce.ShopCouponUpdate]) (*woocommerce.BatchResponse[CP], error) {
+	return backend.Batch[CP, woocommerce.ShopCouponUpdate, CP](ctx, c.backend, pathBatch, request)
+}
@@ -0,0 +1,99 @@
+package coupon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.ShopCoupon](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	expires := woocommerce.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	created, err := client.Create(&woocommerce.ShopCoupon{
+		Code:              "SUMMER10",
+		DiscountType:      woocommerce.CouponTypePercent,
+		Amount:            10,
+		DateExpires:       &expires,
+		UsageLimit:        woocommerce.Ptr(100),
+		EmailRestrictions: []string{"*@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Code != "summer10" || created.Amount != 10 || created.UsageLimit == nil || *created.UsageLimit != 100 {
+		t.Errorf("unexpected created coupon: %+v", created)
+	}
+	if created.DateExpires == nil || !created.DateExpires.Equal(expires.Time) {
+		t.Errorf("unexpected expiry date: %v", created.DateExpires)
+	}
+
+	// Codes are unique.
+	if _, err := client.Create(&woocommerce.ShopCoupon{Code: "Summer10"}); err == nil {
+		t.Error("expected error for duplicated code")
+	}
+
+	found, err := client.RetrieveByCode("Summer10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.ID != created.ID {
+		t.Errorf("expected coupon %d, got %d", created.ID, found.ID)
+	}
+	if _, err := client.RetrieveByCode("missing"); !errors.Is(err, woocommerce.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	updated, err := client.Update(created.ID, woocommerce.ShopCouponUpdate{
+		FreeShipping: woocommerce.Ptr(true),
+		ProductIDs:   woocommerce.Ptr([]int{12, 13}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.FreeShipping || updated.Amount != 10 || len(updated.ProductIDs) != 2 {
+		t.Errorf("unexpected updated coupon: %+v", updated)
+	}
+
+	// Fields can be unset.
+	updated, err = client.Update(created.ID, woocommerce.ShopCouponUpdate{
+		FreeShipping: woocommerce.Ptr(false),
+		ProductIDs:   woocommerce.Ptr([]int{}),
+		DateExpires:  &woocommerce.NullTime{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.FreeShipping || len(updated.ProductIDs) != 0 || updated.Amount != 10 {
+		t.Errorf("expected free shipping and product restriction to be removed: %+v", updated)
+	}
+	if updated.DateExpires != nil {
+		t.Errorf("expected expiry date to be removed, got %v", updated.DateExpires)
+	}
+
+	response, err := client.Batch(woocommerce.BatchRequest[woocommerce.ShopCoupon, woocommerce.ShopCouponUpdate]{
+		Create: []woocommerce.ShopCoupon{{Code: "winter"}, {Code: "summer10"}},
+		Delete: []int{created.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var batchErr *woocommerce.BatchError
+	if err := response.Err(); !errors.As(err, &batchErr) || len(batchErr.Items) != 1 || batchErr.Items[0].Index != 1 {
+		t.Errorf("expected the duplicated code to fail, got %v", err)
+	}
+
+	coupons, total, err := client.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || coupons[0].Code != "winter" {
+		t.Errorf("unexpected coupons: %+v", coupons)
+	}
+}
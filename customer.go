@@ -14,4 +14,24 @@ type Customer struct {
 	IsPayingCustomer bool       `json:"is_paying_customer,omitempty"`
 	AvatarUrl        string     `json:"avatar_url,omitempty"`
 	MetaData         []MetaData `json:"meta_data,omitempty"`
+
+	// Password is only sent when the customer is created or updated. Woocommerce never returns it.
+	Password string `json:"password,omitempty"`
+}
+
+// CustomerDownload is a file of a downloadable product that the customer has access to.
+type CustomerDownload struct {
+	DownloadID   string `json:"download_id"`
+	DownloadURL  string `json:"download_url"`
+	ProductID    int    `json:"product_id"`
+	ProductName  string `json:"product_name"`
+	DownloadName string `json:"download_name"`
+	OrderID      int    `json:"order_id"`
+	OrderKey     string `json:"order_key"`
+	// DownloadsRemaining is the number of remaining downloads or "unlimited".
+	DownloadsRemaining string `json:"downloads_remaining"`
+	// AccessExpires is the date when access to the file expires or "never".
+	AccessExpires    string          `json:"access_expires"`
+	AccessExpiresGMT string          `json:"access_expires_gmt"`
+	File             ProductDownload `json:"file"`
 }
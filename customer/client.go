@@ -1,7 +1,9 @@
 package customer
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,8 +13,10 @@ import (
 )
 
 const (
-	pathList     = "/customers"
-	pathRetrieve = "/customers/%s"
+	pathList      = "/customers"
+	pathRetrieve  = "/customers/%s"
+	pathBatch     = "/customers/batch"
+	pathDownloads = "/customers/%d/downloads"
 )
 
 // Client is the API client used for working with customers.
@@ -23,22 +27,28 @@ const (
 // If you have extensions installed on woocommerce that add additional fields to the customer,
 // you can create your own type that embeds the woocommerce.Customer type and add additional fields.
 type Client[C any] struct {
-	backend *backend.Backend
+	backend woocommerce.Backend
 }
 
 // New creates a new client for customers.
 // It should not be called directly.
 // Instead, client.API should be used.
-func New[C any](backend *backend.Backend) *Client[C] {
+func New[C any](backend woocommerce.Backend) *Client[C] {
 	return &Client[C]{
 		backend: backend,
 	}
 }
 
 // List returns a list of customers with given parameters and total customer count.
+// Parameters can be ListParams.
 func (c Client[C]) List(parameters woocommerce.Parameters) ([]C, int, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c Client[C]) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]C, int, error) {
 	// Execute authenticated request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -64,13 +74,26 @@ func (c Client[C]) List(parameters woocommerce.Parameters) ([]C, int, error) {
 	return customers, count, nil
 }
 
+// Pager returns a pager that walks all pages of customers with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client[C]) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[C] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[C], error) {
+		return backend.ListPage[C](ctx, c.backend, pathList, parameters)
+	}, options...)
+}
+
 // Retrieve retrieves a single customer by its ID.
 func (c Client[C]) Retrieve(id string) (C, error) {
+	return c.RetrieveContext(context.Background(), id)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c Client[C]) RetrieveContext(ctx context.Context, id string) (C, error) {
 	var empty C
 
 	// Execute authenticated request.
 	path := fmt.Sprintf(pathRetrieve, id)
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
 	if err != nil {
 		return empty, err
 	}
@@ -86,10 +109,57 @@ func (c Client[C]) Retrieve(id string) (C, error) {
 	return customer, nil
 }
 
+// Create creates a new customer and returns it. Email of the customer must be set.
+//
+// Username and password can be set on the customer or with options. If they are missing, woocommerce
+// generates them only if the store is configured to do so. Otherwise, the customer is not created.
+func (c Client[C]) Create(customer *C, options ...CreateOption) (C, error) {
+	return c.CreateContext(context.Background(), customer, options...)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c Client[C]) CreateContext(ctx context.Context, customer *C, options ...CreateOption) (C, error) {
+	var created C
+
+	body, err := newCreateBody(customer, options)
+	if err != nil {
+		return created, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Execute authenticated request.
+		resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, pathList, body.fields, nil, nil)
+		var wcErr *woocommerce.Error
+		if errors.As(err, &wcErr) && wcErr.Code == codeUsernameExists && body.generatedUsername && attempt < maxUsernameAttempts {
+			if err := body.suffixUsername(); err != nil {
+				return created, err
+			}
+			continue
+		}
+		if err != nil {
+			return created, err
+		}
+		defer resp.Body.Close()
+
+		// Unmarshal JSON.
+		err = json.NewDecoder(resp.Body).Decode(&created)
+		if err != nil {
+			return created, fmt.Errorf("[woocommerce-go]: could not unmarshal customer json: %w", err)
+		}
+
+		return created, nil
+	}
+}
+
 // Update updates the given customer. ID of the customer must be set.
 func (c Client[C]) Update(customer *C, id int) error {
+	return c.UpdateContext(context.Background(), customer, id)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c Client[C]) UpdateContext(ctx context.Context, customer *C, id int) error {
 	path := fmt.Sprintf(pathRetrieve, strconv.Itoa(id))
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodPut, path, customer, nil, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, customer, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -103,3 +173,73 @@ func (c Client[C]) Update(customer *C, id int) error {
 
 	return nil
 }
+
+// Delete permanently deletes the customer with a given ID and returns the deleted customer.
+// Woocommerce does not support moving customers to trash. If reassign is not 0, posts of the customer
+// are reassigned to the user with that ID. Orders of the deleted customer are kept as orders of a guest.
+func (c Client[C]) Delete(customerID, reassign int) (C, error) {
+	return c.DeleteContext(context.Background(), customerID, reassign)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c Client[C]) DeleteContext(ctx context.Context, customerID, reassign int) (C, error) {
+	var deleted C
+
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	if reassign != 0 {
+		parameters["reassign"] = []string{strconv.Itoa(reassign)}
+	}
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRetrieve, strconv.Itoa(customerID))
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return deleted, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&deleted)
+	if err != nil {
+		return deleted, fmt.Errorf("[woocommerce-go]: could not unmarshal customer json: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// Downloads returns files of downloadable products that the customer with a given ID has access to.
+// Woocommerce returns all downloads in a single response.
+func (c Client[C]) Downloads(customerID int) ([]*woocommerce.CustomerDownload, error) {
+	return c.DownloadsContext(context.Background(), customerID)
+}
+
+// DownloadsContext is the same as Downloads, but it uses the given context for the request.
+func (c Client[C]) DownloadsContext(ctx context.Context, customerID int) ([]*woocommerce.CustomerDownload, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathDownloads, customerID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var downloads []*woocommerce.CustomerDownload
+	err = json.NewDecoder(resp.Body).Decode(&downloads)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal customer downloads json: %w", err)
+	}
+
+	return downloads, nil
+}
+
+// Batch creates, updates and deletes multiple customers. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Customers that could not be processed are reported by the Err method of the response.
+func (c Client[C]) Batch(request woocommerce.BatchRequest[C, C]) (*woocommerce.BatchResponse[C], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c Client[C]) BatchContext(ctx context.Context, request woocommerce.BatchRequest[C, C]) (*woocommerce.BatchResponse[C], error) {
+	return backend.Batch[C, C, C](ctx, c.backend, pathBatch, request)
+}
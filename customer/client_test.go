@@ -0,0 +1,209 @@
+package customer
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestClient_Create(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Customer](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	jane, err := client.Create(&woocommerce.Customer{Email: "jane@example.com", FirstName: "Jane"}, WithUsername("jane"), WithPassword("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jane.ID == 0 || jane.Username != "jane" || jane.Password != "" {
+		t.Errorf("unexpected customer %+v", jane)
+	}
+
+	// The username is taken, so it can not be created without a suffix.
+	if _, err := client.Create(&woocommerce.Customer{Email: "jane@example.org", Username: "jane"}); err == nil {
+		t.Error("expected an error for a taken username")
+	}
+
+	var password string
+	other, err := client.Create(&woocommerce.Customer{Email: "Jane+Shop@example.org"}, WithGeneratedUsername(), WithGeneratedPassword(&password))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Username != "janeshop" {
+		t.Errorf("expected username janeshop, got %s", other.Username)
+	}
+	if len(password) != PasswordLength {
+		t.Errorf("expected a generated password of %d characters, got %q", PasswordLength, password)
+	}
+
+	// Generated usernames get a random suffix when they are taken.
+	third, err := client.Create(&woocommerce.Customer{Email: "jane@example.net"}, WithGeneratedUsername())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(third.Username, "jane") || len(third.Username) != len("jane")+4 {
+		t.Errorf("expected a suffixed username, got %s", third.Username)
+	}
+
+	var wcErr *woocommerce.Error
+	if _, err := client.Create(&woocommerce.Customer{FirstName: "Nobody"}); !errors.As(err, &wcErr) {
+		t.Errorf("expected an API error for a missing email, got %v", err)
+	}
+}
+
+func TestClient_Delete(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Customer](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	jane, err := client.Create(&woocommerce.Customer{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	john, err := client.Create(&woocommerce.Customer{Email: "john@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	orderID, err := srv.Add(wctest.ResourceOrders, woocommerce.Order{CustomerID: jane.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Delete(jane.ID, jane.ID); err == nil {
+		t.Error("expected an error when reassigning to the deleted customer")
+	}
+
+	deleted, err := client.Delete(jane.ID, john.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Email != "jane@example.com" {
+		t.Errorf("unexpected deleted customer %+v", deleted)
+	}
+	if _, err := client.Retrieve(strconv.Itoa(jane.ID)); err == nil {
+		t.Error("expected the customer to be deleted")
+	}
+
+	var order woocommerce.Order
+	srv.Get(wctest.ResourceOrders, orderID, &order)
+	if order.CustomerID != 0 {
+		t.Errorf("expected the order to belong to a guest, got customer %d", order.CustomerID)
+	}
+}
+
+func TestClient_Downloads(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Customer](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	jane, err := client.Create(&woocommerce.Customer{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ebook, err := srv.Add(wctest.ResourceProducts, woocommerce.Product{
+		Name: "Ebook",
+		ProductCommon: woocommerce.ProductCommon{
+			Downloadable:  true,
+			Downloads:     []woocommerce.ProductDownload{{ID: "f1", Name: "PDF", File: "https://example.com/ebook.pdf"}},
+			DownloadLimit: 3,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hoodie, err := srv.Add(wctest.ResourceProducts, woocommerce.Product{Name: "Hoodie"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range []string{"processing", "pending"} {
+		_, err := srv.Add(wctest.ResourceOrders, map[string]interface{}{
+			"customer_id": jane.ID,
+			"status":      status,
+			"line_items":  []map[string]interface{}{{"product_id": ebook}, {"product_id": hoodie}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	downloads, err := client.Downloads(jane.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(downloads) != 1 {
+		t.Fatalf("expected 1 download, got %d", len(downloads))
+	}
+	download := downloads[0]
+	if download.DownloadID != "f1" || download.ProductID != ebook || download.DownloadsRemaining != "3" ||
+		download.AccessExpires != "never" || download.File.File != "https://example.com/ebook.pdf" {
+		t.Errorf("unexpected download %+v", download)
+	}
+
+	if _, err := client.Downloads(jane.ID + 100); err == nil {
+		t.Error("expected an error for a missing customer")
+	}
+}
+
+func TestClient_FindByEmail(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Customer](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	if _, err := client.Create(&woocommerce.Customer{Email: "jane@example.com", Role: "shop_manager"}); err != nil {
+		t.Fatal(err)
+	}
+	guestOrders := []woocommerce.Order{
+		{Billing: woocommerce.Address{Email: "guest@example.com", FirstName: "Old", Country: "SI"}},
+		{Billing: woocommerce.Address{Email: "guest@example.com", FirstName: "Gina", Country: "SI"}, Shipping: woocommerce.Address{City: "Ljubljana"}},
+		// Found by search, but the email does not match.
+		{Billing: woocommerce.Address{Email: "otherguest@example.com", FirstName: "Other"}},
+	}
+	for _, order := range guestOrders {
+		if _, err := srv.Add(wctest.ResourceOrders, order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	jane, err := client.FindByEmail(" jane@example.com ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jane.ID == 0 || jane.Role != "shop_manager" {
+		t.Errorf("unexpected customer %+v", jane)
+	}
+
+	guest, err := client.FindByEmail("Guest@Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if guest.ID != 0 || guest.FirstName != "Gina" || guest.Billing.Country != "SI" || guest.Shipping.City != "Ljubljana" {
+		t.Errorf("unexpected guest %+v", guest)
+	}
+
+	if _, err := client.FindByEmail("nobody@example.com"); !errors.Is(err, woocommerce.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.FindByEmail("nobody"); !errors.Is(err, woocommerce.ErrInvalidParameters) {
+		t.Errorf("expected ErrInvalidParameters, got %v", err)
+	}
+}
+
+func TestUsernameFromEmail(t *testing.T) {
+	tests := map[string]string{
+		"jane@example.com":        "jane",
+		" Jane.Doe@example.com ":  "jane.doe",
+		"jane+shop@example.com":   "janeshop",
+		"\"weird\"@x@example.com": "weirdx",
+		"+++@example.com":         "customer",
+	}
+	for email, expected := range tests {
+		if username := usernameFromEmail(email); username != expected {
+			t.Errorf("expected %s for %q, got %s", expected, email, username)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package customer
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// PasswordLength is the length of passwords generated by WithGeneratedPassword.
+	PasswordLength = 24
+
+	// maxUsernameAttempts is the number of usernames tried by WithGeneratedUsername.
+	maxUsernameAttempts = 5
+	// codeUsernameExists is the error code returned by woocommerce when the username is taken.
+	codeUsernameExists = "registration-error-username-exists"
+
+	passwordChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!@#$%^&*()"
+)
+
+// CreateOption sets credentials of a customer created with Client.Create.
+type CreateOption func(*createOptions)
+
+type createOptions struct {
+	username         string
+	password         string
+	generateUsername bool
+	generatePassword bool
+	generated        *string
+}
+
+// WithUsername sets the username of the created customer, overriding the username of the customer.
+func WithUsername(username string) CreateOption {
+	return func(o *createOptions) {
+		o.username = username
+	}
+}
+
+// WithPassword sets the password of the created customer, overriding the password of the customer.
+func WithPassword(password string) CreateOption {
+	return func(o *createOptions) {
+		o.password = password
+	}
+}
+
+// WithGeneratedUsername generates the username from the email of the customer if it has no username,
+// the same way as woocommerce does when the store is set to generate usernames. If the username is taken,
+// a random number is appended to it and the customer is created again.
+func WithGeneratedUsername() CreateOption {
+	return func(o *createOptions) {
+		o.generateUsername = true
+	}
+}
+
+// WithGeneratedPassword generates a random password of PasswordLength characters if the customer has no password.
+// The generated password is stored to password, if it is not nil, so it can be sent to the customer.
+func WithGeneratedPassword(password *string) CreateOption {
+	return func(o *createOptions) {
+		o.generatePassword = true
+		o.generated = password
+	}
+}
+
+// createBody is the body of the create request. The customer is sent as a JSON object,
+// so credentials can be set regardless of the customer type.
+type createBody struct {
+	fields map[string]json.RawMessage
+
+	// username is the generated username without a suffix.
+	username          string
+	generatedUsername bool
+}
+
+func newCreateBody(customer interface{}, options []CreateOption) (*createBody, error) {
+	var opts createOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	data, err := json.Marshal(customer)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not marshal customer: %w", err)
+	}
+	body := &createBody{}
+	if err := json.Unmarshal(data, &body.fields); err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: customer must marshal to a JSON object: %w", err)
+	}
+	if body.fields == nil {
+		return nil, fmt.Errorf("[woocommerce-go]: customer must not be nil")
+	}
+
+	switch {
+	case opts.username != "":
+		body.set("username", opts.username)
+	case opts.generateUsername && body.get("username") == "":
+		body.username = usernameFromEmail(body.get("email"))
+		body.generatedUsername = true
+		body.set("username", body.username)
+	}
+
+	switch {
+	case opts.password != "":
+		body.set("password", opts.password)
+	case opts.generatePassword && body.get("password") == "":
+		password, err := generatePassword(PasswordLength)
+		if err != nil {
+			return nil, err
+		}
+		body.set("password", password)
+		if opts.generated != nil {
+			*opts.generated = password
+		}
+	}
+
+	return body, nil
+}
+
+func (b *createBody) get(key string) string {
+	var value string
+	_ = json.Unmarshal(b.fields[key], &value)
+	return value
+}
+
+func (b *createBody) set(key, value string) {
+	data, _ := json.Marshal(value)
+	b.fields[key] = data
+}
+
+// suffixUsername appends a random four digit number to the generated username, as woocommerce does.
+func (b *createBody) suffixUsername() error {
+	n, err := rand.Int(rand.Reader, big.NewInt(10000))
+	if err != nil {
+		return fmt.Errorf("[woocommerce-go]: could not generate username: %w", err)
+	}
+	b.set("username", fmt.Sprintf("%s%04d", b.username, n.Int64()))
+	return nil
+}
+
+// usernameFromEmail returns the part of the email before @, stripped of characters
+// that are not allowed in usernames.
+func usernameFromEmail(email string) string {
+	local := strings.ToLower(strings.TrimSpace(email))
+	if i := strings.LastIndex(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+
+	var b strings.Builder
+	for _, r := range local {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-' {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "customer"
+	}
+	return b.String()
+}
+
+func generatePassword(length int) (string, error) {
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(passwordChars))))
+		if err != nil {
+			return "", fmt.Errorf("[woocommerce-go]: could not generate password: %w", err)
+		}
+		password[i] = passwordChars[n.Int64()]
+	}
+	return string(password), nil
+}
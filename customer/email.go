@@ -0,0 +1,88 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const pathOrders = "/orders"
+
+// FindByEmail returns the customer with the given email. Customers of all roles are considered.
+//
+// The email filter of woocommerce matches whole emails only, so surrounding whitespace is removed
+// before the lookup. Emails are compared case-insensitively.
+//
+// If no customer has the email, orders of guests are searched for the billing email.
+// The customer is then built from billing and shipping addresses of the latest such order. It has ID 0
+// and only the email, names and addresses set. If there is no such order either, woocommerce.ErrNotFound is returned.
+func (c Client[C]) FindByEmail(email string) (C, error) {
+	return c.FindByEmailContext(context.Background(), email)
+}
+
+// FindByEmailContext is the same as FindByEmail, but it uses the given context for the request.
+func (c Client[C]) FindByEmailContext(ctx context.Context, email string) (C, error) {
+	var customer C
+
+	email = strings.TrimSpace(email)
+	customers, _, err := c.ListContext(ctx, ListParams{Email: email, Role: RoleAll})
+	if err != nil {
+		return customer, err
+	}
+	if len(customers) > 0 {
+		return customers[0], nil
+	}
+
+	order, err := c.latestGuestOrder(ctx, email)
+	if err != nil {
+		return customer, err
+	}
+	if order == nil {
+		return customer, fmt.Errorf("%w: customer with email %q", woocommerce.ErrNotFound, email)
+	}
+
+	// Convert the guest to the customer type through JSON, so that it works with custom customer types.
+	guest := woocommerce.Customer{
+		Email:     order.Billing.Email,
+		FirstName: order.Billing.FirstName,
+		LastName:  order.Billing.LastName,
+		Billing:   order.Billing,
+		Shipping:  order.Shipping,
+	}
+	data, err := json.Marshal(guest)
+	if err != nil {
+		return customer, fmt.Errorf("[woocommerce-go]: could not marshal guest customer: %w", err)
+	}
+	err = json.Unmarshal(data, &customer)
+	if err != nil {
+		return customer, fmt.Errorf("[woocommerce-go]: could not unmarshal guest customer: %w", err)
+	}
+
+	return customer, nil
+}
+
+// latestGuestOrder returns the latest order of a guest with the billing email, or nil if there is no such order.
+// Woocommerce searches orders by substrings, so billing emails of found orders are compared with the email.
+func (c Client[C]) latestGuestOrder(ctx context.Context, email string) (*woocommerce.Order, error) {
+	parameters := woocommerce.BaseParameters{
+		"search":   {email},
+		"customer": {"0"},
+		"orderby":  {"date"},
+		"order":    {"desc"},
+	}
+	pager := woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.Order], error) {
+		return backend.ListPage[*woocommerce.Order](ctx, c.backend, pathOrders, parameters)
+	})
+	defer pager.Close()
+
+	for pager.Next(ctx) {
+		if order := pager.Item(); strings.EqualFold(strings.TrimSpace(order.Billing.Email), email) {
+			return order, nil
+		}
+	}
+	return nil, pager.Err()
+}
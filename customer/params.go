@@ -0,0 +1,98 @@
+package customer
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// OrderBy is the attribute by which customers are sorted.
+type OrderBy string
+
+const (
+	OrderByID             OrderBy = "id"
+	OrderByInclude        OrderBy = "include"
+	OrderByName           OrderBy = "name"
+	OrderByRegisteredDate OrderBy = "registered_date"
+)
+
+// Role is the role of a WordPress user. Stores can register additional roles.
+type Role string
+
+const (
+	RoleAll           Role = "all"
+	RoleAdministrator Role = "administrator"
+	RoleEditor        Role = "editor"
+	RoleAuthor        Role = "author"
+	RoleContributor   Role = "contributor"
+	RoleSubscriber    Role = "subscriber"
+	RoleCustomer      Role = "customer"
+	RoleShopManager   Role = "shop_manager"
+)
+
+// ListParams are parameters for listing customers. Zero values are omitted from the request,
+// so woocommerce defaults are used for them.
+type ListParams struct {
+	woocommerce.PageParams
+
+	// Search limits results to customers matching the string.
+	Search string
+	// Email limits results to the customer with the email. Woocommerce only matches whole emails.
+	Email string
+	// Role limits results to users with the role. By default, woocommerce lists users with the customer role.
+	Role Role
+
+	Include []int
+	Exclude []int
+
+	OrderBy OrderBy
+	Order   woocommerce.SortOrder
+}
+
+func (p ListParams) Values() url.Values {
+	values := p.PageParams.Values()
+
+	backend.SetString(values, "search", p.Search)
+	backend.SetString(values, "email", p.Email)
+	backend.SetString(values, "role", string(p.Role))
+
+	backend.SetInts(values, "include", p.Include)
+	backend.SetInts(values, "exclude", p.Exclude)
+
+	backend.SetString(values, "orderby", string(p.OrderBy))
+	backend.SetString(values, "order", string(p.Order))
+	return values
+}
+
+// Validate checks the parameters for values that woocommerce would reject
+// and for combinations that can not match any customer.
+func (p ListParams) Validate() error {
+	if err := p.PageParams.Validate(); err != nil {
+		return err
+	}
+	if err := p.Order.Validate(); err != nil {
+		return err
+	}
+
+	switch p.OrderBy {
+	case "", OrderByID, OrderByInclude, OrderByName, OrderByRegisteredDate:
+	default:
+		return fmt.Errorf("%w: unknown orderby value %q", woocommerce.ErrInvalidParameters, p.OrderBy)
+	}
+	if p.OrderBy == OrderByInclude && len(p.Include) == 0 {
+		return fmt.Errorf("%w: orderby include requires include to be set", woocommerce.ErrInvalidParameters)
+	}
+
+	if p.Email != "" && !strings.Contains(p.Email, "@") {
+		return fmt.Errorf("%w: invalid email %q", woocommerce.ErrInvalidParameters, p.Email)
+	}
+
+	if id, ok := backend.Overlap(p.Include, p.Exclude); ok {
+		return fmt.Errorf("%w: customer %d is both included and excluded", woocommerce.ErrInvalidParameters, id)
+	}
+
+	return nil
+}
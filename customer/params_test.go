@@ -0,0 +1,83 @@
+package customer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestListParams_Values(t *testing.T) {
+	params := ListParams{
+		PageParams: woocommerce.PageParams{Page: 2},
+		Email:      "jane@example.com",
+		Role:       RoleAll,
+		Exclude:    []int{3, 5},
+		OrderBy:    OrderByRegisteredDate,
+		Order:      woocommerce.SortOrderDesc,
+	}
+
+	expected := "email=jane%40example.com&exclude=3%2C5&order=desc&orderby=registered_date&page=2&role=all"
+	if got := params.Values().Encode(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestListParams_Validate(t *testing.T) {
+	cases := []struct {
+		name   string
+		params ListParams
+		valid  bool
+	}{
+		{"empty", ListParams{}, true},
+		{"custom role", ListParams{Role: "wholesale_customer"}, true},
+		{"unknown orderby", ListParams{OrderBy: "email"}, false},
+		{"orderby include without include", ListParams{OrderBy: OrderByInclude}, false},
+		{"invalid email", ListParams{Email: "jane"}, false},
+		{"include and exclude", ListParams{Include: []int{1, 2}, Exclude: []int{2}}, false},
+	}
+
+	for _, c := range cases {
+		err := c.params.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if !c.valid && !errors.Is(err, woocommerce.ErrInvalidParameters) {
+			t.Errorf("%s: expected ErrInvalidParameters, got %v", c.name, err)
+		}
+	}
+}
+
+func TestClient_ListParams(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Customer](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	for _, c := range []woocommerce.Customer{
+		{Email: "jane@example.com"},
+		{Email: "john@example.com"},
+		{Email: "admin@example.com", Role: string(RoleShopManager)},
+	} {
+		if _, err := client.Create(&c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	customers, total, err := client.List(ListParams{Role: RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || len(customers) != 2 {
+		t.Errorf("expected 2 customers, got %d of %d", len(customers), total)
+	}
+
+	customers, _, err = client.List(ListParams{Email: "admin@example.com", Role: RoleAll})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(customers) != 1 || customers[0].Role != string(RoleShopManager) {
+		t.Errorf("unexpected customers %+v", customers)
+	}
+}
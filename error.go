@@ -1,5 +1,7 @@
 package woocommerce
 
+import "errors"
+
 type ErrorDetails struct {
 	Code             string         `json:"code"`
 	Message          string         `json:"message"`
@@ -20,3 +22,7 @@ type Error struct {
 func (e *Error) Error() string {
 	return e.Message
 }
+
+// ErrNotFound is returned by lookup methods, such as lookup of a coupon by its code,
+// when no object matches.
+var ErrNotFound = errors.New("[woocommerce-go]: not found")
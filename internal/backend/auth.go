@@ -0,0 +1,189 @@
+package backend
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Authenticator authenticates requests to the woocommerce server.
+// See https://woocommerce.github.io/woocommerce-rest-api-docs/#authentication
+type Authenticator interface {
+	// Authenticate adds credentials to the request.
+	// It is called for every attempt of the request, right before it is sent.
+	Authenticate(req *http.Request) error
+}
+
+// BasicAuth authenticates requests with HTTP Basic authentication.
+// This is the default authentication. Woocommerce only accepts it on stores served over HTTPS.
+type BasicAuth struct {
+	ConsumerKey    string
+	ConsumerSecret string
+}
+
+func (a BasicAuth) Authenticate(req *http.Request) error {
+	auth := a.ConsumerKey + ":" + a.ConsumerSecret
+	req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	return nil
+}
+
+// ApplicationPassword authenticates requests as a WordPress user with an application password.
+// See https://make.wordpress.org/core/2020/11/05/application-passwords-integration-guide/
+type ApplicationPassword struct {
+	Username string
+	// Password is the application password. It can be given with or without spaces.
+	Password string
+}
+
+func (a ApplicationPassword) Authenticate(req *http.Request) error {
+	password := strings.ReplaceAll(a.Password, " ", "")
+	req.SetBasicAuth(a.Username, password)
+	return nil
+}
+
+// QueryStringAuth authenticates requests by passing consumer key and consumer secret
+// as query parameters. It can be used on HTTPS stores whose server does not parse the Authorization header.
+type QueryStringAuth struct {
+	ConsumerKey    string
+	ConsumerSecret string
+}
+
+func (a QueryStringAuth) Authenticate(req *http.Request) error {
+	query := req.URL.Query()
+	query.Set("consumer_key", a.ConsumerKey)
+	query.Set("consumer_secret", a.ConsumerSecret)
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// SignatureMethod is the method used for signing OAuth 1.0a requests.
+type SignatureMethod string
+
+const (
+	SignatureMethodHMACSHA1   SignatureMethod = "HMAC-SHA1"
+	SignatureMethodHMACSHA256 SignatureMethod = "HMAC-SHA256"
+)
+
+// OAuth1 authenticates requests with one-legged OAuth 1.0a, which
+// woocommerce requires on stores served over plain HTTP.
+// OAuth parameters are sent as query parameters, as woocommerce does not read them from the Authorization header.
+type OAuth1 struct {
+	ConsumerKey    string
+	ConsumerSecret string
+	// SignatureMethod defaults to SignatureMethodHMACSHA256.
+	SignatureMethod SignatureMethod
+
+	// now and nonce can be replaced in tests.
+	now   func() time.Time
+	nonce func() string
+}
+
+func (a OAuth1) Authenticate(req *http.Request) error {
+	method := a.SignatureMethod
+	if method == "" {
+		method = SignatureMethodHMACSHA256
+	}
+
+	var h func() hash.Hash
+	switch method {
+	case SignatureMethodHMACSHA1:
+		h = sha1.New
+	case SignatureMethodHMACSHA256:
+		h = sha256.New
+	default:
+		return fmt.Errorf("[woocommerce-go]: invalid OAuth signature method: %s", method)
+	}
+
+	now := time.Now
+	if a.now != nil {
+		now = a.now
+	}
+	nonce := randomToken
+	if a.nonce != nil {
+		nonce = a.nonce
+	}
+
+	query := req.URL.Query()
+	query.Set("oauth_consumer_key", a.ConsumerKey)
+	query.Set("oauth_nonce", nonce())
+	query.Set("oauth_signature_method", string(method))
+	query.Set("oauth_timestamp", strconv.FormatInt(now().Unix(), 10))
+
+	// Woocommerce does not use tokens, so the token secret of the key is empty.
+	baseString := oauthBaseString(req.Method, req.URL, query)
+	query.Set("oauth_signature", oauthSignature(h, a.ConsumerSecret+"&", baseString))
+
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// oauthBaseString builds the signature base string from the method,
+// the URL without query and the sorted, percent encoded parameters.
+func oauthBaseString(method string, u *url.URL, params url.Values) string {
+	type pair struct {
+		key, value string
+	}
+	pairs := make([]pair, 0, len(params))
+	for key, values := range params {
+		for _, value := range values {
+			pairs = append(pairs, pair{key: percentEncode(key), value: percentEncode(value)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+
+	joined := make([]string, len(pairs))
+	for i, p := range pairs {
+		joined[i] = p.key + "=" + p.value
+	}
+
+	baseURL := &url.URL{
+		Scheme: strings.ToLower(u.Scheme),
+		Host:   strings.ToLower(u.Host),
+		Path:   u.Path,
+	}
+	// Default ports are not part of the base URL.
+	if (baseURL.Scheme == "http" && strings.HasSuffix(baseURL.Host, ":80")) ||
+		(baseURL.Scheme == "https" && strings.HasSuffix(baseURL.Host, ":443")) {
+		baseURL.Host = baseURL.Host[:strings.LastIndex(baseURL.Host, ":")]
+	}
+
+	return strings.ToUpper(method) + "&" + percentEncode(baseURL.String()) + "&" + percentEncode(strings.Join(joined, "&"))
+}
+
+// oauthSignature signs the base string with the key, which is the consumer secret and
+// the token secret joined with &.
+func oauthSignature(h func() hash.Hash, key, baseString string) string {
+	mac := hmac.New(h, []byte(key))
+	mac.Write([]byte(baseString))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode encodes the string as specified in RFC 3986.
+// Only unreserved characters are left as they are.
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,214 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+const (
+	defaultJWTTokenPath = "/wp-json/jwt-auth/v1/token"
+	// defaultJWTLifetime is used when the token does not contain the exp claim.
+	defaultJWTLifetime = time.Hour
+	// jwtExpiryMargin makes tokens refresh slightly before they expire.
+	jwtExpiryMargin = 30 * time.Second
+)
+
+// TokenAuthenticator is an Authenticator that uses tokens that can be rejected by the server
+// before their expected expiry. If a request fails with 401 status code and one of
+// rest_forbidden or jwt_auth_invalid_token error codes, Invalidate is called with the failed
+// request and the request is sent once more.
+type TokenAuthenticator interface {
+	Authenticator
+	// Invalidate discards the token used for the given request.
+	Invalidate(req *http.Request)
+}
+
+// backendBinder is implemented by authenticators that need to execute their own requests.
+type backendBinder interface {
+	bind(b *Backend)
+}
+
+// JWTAuth authenticates requests with a bearer token issued by a WordPress JWT authentication plugin.
+// Token is acquired from the token endpoint on the first request and cached until it expires.
+// It should be created with the NewJWTAuth function.
+type JWTAuth struct {
+	username string
+	password string
+	// tokenPath is the path of the token endpoint, relative to the base URL of the store.
+	tokenPath string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+
+	baseURL    string
+	httpClient *http.Client
+	userAgent  string
+}
+
+// NewJWTAuth creates a new JWT authenticator for the WordPress user with given credentials.
+// TokenPath is the path of the token endpoint relative to the base URL of the store. If empty,
+// /wp-json/jwt-auth/v1/token is used, which is the endpoint of the most common JWT plugins.
+func NewJWTAuth(username, password, tokenPath string) *JWTAuth {
+	if tokenPath == "" {
+		tokenPath = defaultJWTTokenPath
+	}
+
+	return &JWTAuth{
+		username:  username,
+		password:  password,
+		tokenPath: tokenPath,
+	}
+}
+
+func (a *JWTAuth) bind(b *Backend) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.baseURL = b.baseURL
+	a.httpClient = b.httpClient
+	a.userAgent = b.userAgent
+}
+
+func (a *JWTAuth) Authenticate(req *http.Request) error {
+	token, err := a.getToken(req.Context())
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *JWTAuth) Invalidate(req *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Do not discard a token that was already refreshed by another request.
+	if req.Header.Get("Authorization") == "Bearer "+a.token {
+		a.token = ""
+	}
+}
+
+// getToken returns the cached token or acquires a new one if it is missing or expired.
+func (a *JWTAuth) getToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Before(a.expires) {
+		return a.token, nil
+	}
+
+	if a.httpClient == nil {
+		return "", fmt.Errorf("[woocommerce-go]: JWT authenticator is not bound to a backend")
+	}
+
+	// Request a new token.
+	body, err := json.Marshal(map[string]string{
+		"username": a.username,
+		"password": a.password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[woocommerce-go]: could not marshal JWT credentials: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+a.tokenPath, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("[woocommerce-go]: could not create JWT token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", a.userAgent)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("[woocommerce-go]: could not execute JWT token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var err = &woocommerce.Error{}
+		_ = json.NewDecoder(resp.Body).Decode(&err)
+		err.StatusCode = resp.StatusCode
+		return "", err
+	}
+
+	// Plugins return the token either at the top level or in the data object.
+	var tokenResp struct {
+		Token string `json:"token"`
+		Data  struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("[woocommerce-go]: could not unmarshal JWT token json: %w", err)
+	}
+
+	token := tokenResp.Token
+	if token == "" {
+		token = tokenResp.Data.Token
+	}
+	if token == "" {
+		return "", fmt.Errorf("[woocommerce-go]: JWT token response does not contain a token")
+	}
+
+	a.token = token
+	a.expires = jwtExpiry(token).Add(-jwtExpiryMargin)
+	return token, nil
+}
+
+// jwtExpiry returns expiry time from the exp claim of the token.
+// The token is not verified, since that is the job of the server.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Now().Add(defaultJWTLifetime)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Now().Add(defaultJWTLifetime)
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Now().Add(defaultJWTLifetime)
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
+
+// invalidTokenCodes are error codes returned by WordPress and JWT plugins when the token is not valid.
+var invalidTokenCodes = map[string]bool{
+	"rest_forbidden":         true,
+	"jwt_auth_invalid_token": true,
+}
+
+// tokenRejected checks whether the response was rejected because of an invalid token.
+// The body of the response is consumed and replaced, so that it can be read again.
+func tokenRejected(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+
+	data, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	var wcErr woocommerce.Error
+	if err := json.Unmarshal(data, &wcErr); err != nil {
+		return false
+	}
+	return invalidTokenCodes[wcErr.Code]
+}
@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testJWT(id int, expires time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d,"id":%d}`, expires.Unix(), id)))
+	return header + "." + payload + ".signature"
+}
+
+func TestJWTAuth(t *testing.T) {
+	var tokensIssued int
+	validToken := ""
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(defaultJWTTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		var credentials map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&credentials)
+		if credentials["username"] != "shop-manager" || credentials["password"] != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"code":"[jwt_auth] incorrect_password","message":"Incorrect password"}`))
+			return
+		}
+
+		tokensIssued++
+		validToken = testJWT(tokensIssued, time.Now().Add(time.Hour))
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": validToken})
+	})
+	mux.HandleFunc(urlPathPrefixRest+"/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":"jwt_auth_invalid_token","message":"Expired token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	auth := NewJWTAuth("shop-manager", "secret", "")
+	b := New(server.URL, "", "", WithAuthenticator(auth))
+
+	request := func() {
+		t.Helper()
+		resp, err := b.AuthenticatedRequest(APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	// Token is acquired once and cached.
+	request()
+	request()
+	if tokensIssued != 1 {
+		t.Fatalf("expected 1 issued token, got %d", tokensIssued)
+	}
+
+	// Token is refreshed when the server rejects it.
+	validToken = "revoked"
+	request()
+	if tokensIssued != 2 {
+		t.Fatalf("expected 2 issued tokens, got %d", tokensIssued)
+	}
+}
+
+func TestJWTAuth_Limits(t *testing.T) {
+	validToken := ""
+	var b *Backend
+	var inFlight int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(defaultJWTTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		// The rejected request keeps its limiter slot while the token is refreshed.
+		inFlight = b.LimiterStats(APITypeRest).InFlight
+		validToken = testJWT(1, time.Now().Add(time.Hour))
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": validToken})
+	})
+	mux.HandleFunc(urlPathPrefixRest+"/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":"jwt_auth_invalid_token","message":"Expired token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	auth := NewJWTAuth("shop-manager", "secret", "")
+	b = New(server.URL, "", "", WithAuthenticator(auth), WithLimits(APITypeRest, Limits{MaxInFlight: 1}))
+
+	// The first token is rejected by the server.
+	auth.token = "revoked"
+	auth.expires = time.Now().Add(time.Hour)
+
+	resp, err := b.AuthenticatedRequest(APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inFlight != 1 {
+		t.Errorf("expected the request to stay in flight during the refresh, got %d requests in flight", inFlight)
+	}
+	if stats := b.LimiterStats(APITypeRest); stats.InFlight != 1 || stats.Requests != 1 {
+		t.Errorf("expected the replayed request to use the slot of the rejected one, got %+v", stats)
+	}
+	_ = resp.Body.Close()
+	if stats := b.LimiterStats(APITypeRest); stats.InFlight != 0 {
+		t.Errorf("expected the slot to be released with the response, got %d requests in flight", stats.InFlight)
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	expires := time.Unix(1900000000, 0)
+	if e := jwtExpiry(testJWT(1, expires)); !e.Equal(expires) {
+		t.Fatalf("expected expiry %s, got %s", expires, e)
+	}
+
+	if e := jwtExpiry("not a token"); e.Before(time.Now()) {
+		t.Fatalf("expected default expiry in the future, got %s", e)
+	}
+}
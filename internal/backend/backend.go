@@ -2,7 +2,7 @@ package backend
 
 import (
 	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,14 +18,13 @@ const (
 	urlPathPrefixBlocks = "/wp-json/wc/store/v1"
 )
 
-// APIType is the type of the API to call. Woocommerce has two APIs:
-// - [REST API](https://woocommerce.github.io/woocommerce-rest-api-docs/)
-// - [Blocks API](https://github.com/woocommerce/woocommerce-blocks/tree/trunk/src/StoreApi)
-type APIType string
+// APIType is the type of the API to call. It is defined in the woocommerce package,
+// so that it can be used in the woocommerce.Backend interface.
+type APIType = woocommerce.APIType
 
 const (
-	APITypeRest   APIType = "rest"
-	APITypeBlocks APIType = "blocks"
+	APITypeRest   = woocommerce.APITypeRest
+	APITypeBlocks = woocommerce.APITypeBlocks
 )
 
 type ErrInvalidStatusCode struct {
@@ -42,22 +41,71 @@ func (e ErrInvalidStatusCode) Error() string {
 // execution of authenticate requests.
 // It should be initialized with the New method.
 type Backend struct {
-	baseURL             string
-	basicAuthentication string
+	baseURL       string
+	authenticator Authenticator
+	// storeAuthenticator authenticates Store API requests. If nil, they are sent without authentication.
+	storeAuthenticator Authenticator
+
+	// httpClient is shared between all requests so that connections are reused.
+	httpClient  *http.Client
+	userAgent   string
+	baseHeaders map[string]string
+	retryPolicy RetryPolicy
+	limiters    map[APIType]*limiter
 }
 
 // New creates a new Backend with passed user credentials.
 // ConsumerKey and consumerSecret are gotten from woocommerce admin console.
+// By default, requests are authenticated with HTTP Basic authentication. Use WithAuthenticator,
+// WithOAuth1 or WithQueryStringAuth to change that.
 // BaseURL is the base URL of the store. For instance if the index URL of the woocommerce API is
 // https://example.com/wp-json/wc/v3, then the base URL is https://example.com
-func New(baseURL, consumerKey, consumerSecret string) *Backend {
-	auth := consumerKey + ":" + consumerSecret
-	auth = base64.StdEncoding.EncodeToString([]byte(auth))
+// Options can be used to configure the HTTP client and headers sent with each request.
+func New(baseURL, consumerKey, consumerSecret string, opts ...Option) *Backend {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var auth Authenticator = BasicAuth{ConsumerKey: consumerKey, ConsumerSecret: consumerSecret}
+	if o.authenticator != nil {
+		auth = o.authenticator(consumerKey, consumerSecret)
+	}
+
+	limiters := make(map[APIType]*limiter, len(o.limits))
+	for apiType, limits := range o.limits {
+		limiters[apiType] = newLimiter(limits)
+	}
+
+	b := &Backend{
+		baseURL:            baseURL,
+		authenticator:      auth,
+		storeAuthenticator: o.storeAuthenticator,
+		httpClient:         o.newHTTPClient(),
+		userAgent:          o.userAgent,
+		baseHeaders:        o.baseHeaders,
+		retryPolicy:        o.retryPolicy,
+		limiters:           limiters,
+	}
+
+	// Some authenticators execute their own requests with the backend's client.
+	for _, a := range []Authenticator{b.authenticator, b.storeAuthenticator} {
+		if binder, ok := a.(backendBinder); ok {
+			binder.bind(b)
+		}
+	}
+
+	return b
+}
 
-	return &Backend{
-		baseURL:             baseURL,
-		basicAuthentication: auth,
+// LimiterStats returns metrics of the limiter for the given API type.
+// If limits for the API type are not set, empty stats are returned.
+func (b *Backend) LimiterStats(apiType APIType) LimiterStats {
+	l, ok := b.limiters[apiType]
+	if !ok {
+		return LimiterStats{}
 	}
+	return l.snapshot()
 }
 
 type filterReader struct {
@@ -89,23 +137,34 @@ func (f *filterReader) Read(p []byte) (int, error) {
 // AuthenticatedRequest executes an authenticated request to the woocommerce server.
 // Body can be se to nil to execute a request with an empty body.
 // Parameters can be set to nil to execute a request without GET parameters.
+// Parameters implementing woocommerce.Validator are validated and the request is not sent if they are invalid.
 // Responses with status code not in range of [200, 300) are being treated as an error.
 // The function returns http response and errors that might have occurred during the request execution.
 // If the error is nil, caller is responsible for closing the response body.
 func (b *Backend) AuthenticatedRequest(apiType APIType, method, path string, body interface{}, parameters woocommerce.Parameters, headers map[string]string) (*http.Response, error) {
-	client := &http.Client{
-		Timeout: timeoutDuration,
+	return b.AuthenticatedRequestContext(context.Background(), apiType, method, path, body, parameters, headers)
+}
+
+// AuthenticatedRequestContext is the same as AuthenticatedRequest, but the request is bound to the given context.
+// Cancelling the context aborts the request. In that case the returned error wraps
+// context.Canceled or context.DeadlineExceeded.
+func (b *Backend) AuthenticatedRequestContext(ctx context.Context, apiType APIType, method, path string, body interface{}, parameters woocommerce.Parameters, headers map[string]string) (*http.Response, error) {
+	// Reject invalid parameters before anything is sent.
+	if v, ok := parameters.(woocommerce.Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
 	// Parse the given body if it is not nil.
-	var bodyReader io.Reader = nil
+	// Body is kept as bytes, so that it can be replayed on retries.
+	var bodyBytes []byte
 	if body != nil {
-		bodyBytes, err := json.Marshal(body)
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("[woocommerce-go]: could not marshal body to JSON: %w", err)
 		}
-
-		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
 	// Build the URL.
@@ -123,29 +182,94 @@ func (b *Backend) AuthenticatedRequest(apiType APIType, method, path string, bod
 		reqURL += "?" + parameters.Values().Encode()
 	}
 
-	// Create a new request and set its headers
-	req, err := http.NewRequest(method, reqURL, bodyReader)
-	if err != nil {
-		return nil, fmt.Errorf("[woocommerce-go]: could not create a new request: %w", err)
+	// Retried POST requests carry the same idempotency key on every attempt.
+	idempotencyKey := ""
+	if method == http.MethodPost && b.retryPolicy.RetryPost && b.retryPolicy.MaxAttempts > 1 {
+		idempotencyKey = randomToken()
 	}
 
-	// Remove User-Agent header, because go's default one is blocked by neoserve.
-	req.Header.Set("User-Agent", "")
+	var resp *http.Response
+	refreshed := false
+	// held releases the limiter slot of a request whose token was rejected.
+	// The slot is kept until the token is refreshed and the request is sent once more.
+	var held func()
+	for attempt := 1; ; attempt++ {
+		// Create a new request for every attempt, since the body reader is consumed.
+		req, err := b.newRequest(ctx, apiType, method, reqURL, bodyBytes, headers)
+		if err != nil {
+			if held != nil {
+				held()
+			}
+			return nil, err
+		}
+		if idempotencyKey != "" && req.Header.Get(IdempotencyKeyHeader) == "" {
+			req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+		}
 
-	if apiType == APITypeRest {
-		req.Header.Set("Authorization", "Basic "+b.basicAuthentication)
-	}
-	req.Header.Set("Content-Type", "application/json")
+		// Wait for the limiter of the API.
+		var queueTime time.Duration
+		release := func() {}
+		if held != nil {
+			release, held = held, nil
+		} else if l := b.limiters[apiType]; l != nil {
+			release, queueTime, err = l.wait(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("[woocommerce-go]: could not execute the request: %w", err)
+			}
+		}
 
-	// Set custom headers
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
+		// Execute the request
+		resp, err = b.httpClient.Do(req)
+		if err != nil {
+			release()
+		}
 
-	// Execute the request
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("[woocommerce-go]: could not execute the request: %w", err)
+		// Send the request once more with a new token if the token was rejected.
+		// This does not count as a retry. The body of the rejected response is closed by tokenRejected.
+		if ta, ok := b.authenticatorFor(apiType).(TokenAuthenticator); ok && err == nil && !refreshed && tokenRejected(resp) {
+			refreshed = true
+			held = release
+			ta.Invalidate(req)
+			attempt--
+			continue
+		}
+		if err == nil {
+			// The request stays in flight until its body is closed.
+			resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+		}
+
+		retry, delay := b.retryPolicy.shouldRetry(ctx, method, attempt, resp, err)
+		if b.retryPolicy.OnAttempt != nil {
+			a := Attempt{
+				Number:    attempt,
+				Method:    method,
+				URL:       reqURL,
+				Err:       err,
+				Retry:     retry,
+				Delay:     delay,
+				QueueTime: queueTime,
+			}
+			if resp != nil {
+				a.StatusCode = resp.StatusCode
+			}
+			b.retryPolicy.OnAttempt(a)
+		}
+
+		if !retry {
+			if err != nil {
+				return nil, fmt.Errorf("[woocommerce-go]: could not execute the request: %w", err)
+			}
+			break
+		}
+
+		// Discard the response of the failed attempt and wait before the next one.
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("[woocommerce-go]: could not execute the request: %w", err)
+		}
 	}
 
 	// Check valid response code range.
@@ -172,3 +296,54 @@ func (b *Backend) AuthenticatedRequest(apiType APIType, method, path string, bod
 
 	return resp, nil
 }
+
+// newRequest creates a new request with the given body and sets its headers.
+func (b *Backend) newRequest(ctx context.Context, apiType APIType, method, reqURL string, body []byte, headers map[string]string) (*http.Request, error) {
+	var bodyReader io.Reader = nil
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not create a new request: %w", err)
+	}
+
+	// User-Agent header is empty by default, because go's default one is blocked by neoserve.
+	req.Header.Set("User-Agent", b.userAgent)
+
+	// Set base headers next, so that they can override the User-Agent header
+	// and be overridden by custom headers.
+	for key, value := range b.baseHeaders {
+		req.Header.Set(key, value)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set custom headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Authenticate the request last, since signatures can depend on the rest of the request.
+	if auth := b.authenticatorFor(apiType); auth != nil {
+		if err := auth.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("[woocommerce-go]: could not authenticate the request: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+// authenticatorFor returns the authenticator for the given API type or nil
+// if requests to the API are not authenticated.
+func (b *Backend) authenticatorFor(apiType APIType) Authenticator {
+	switch apiType {
+	case APITypeRest:
+		return b.authenticator
+	case APITypeBlocks:
+		return b.storeAuthenticator
+	default:
+		return nil
+	}
+}
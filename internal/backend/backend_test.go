@@ -2,8 +2,13 @@ package backend
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestFilterReader(t *testing.T) {
@@ -48,3 +53,41 @@ func TestFilterReader(t *testing.T) {
 		})
 	}
 }
+
+func TestBackend_AuthenticatedRequestContext(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+
+	b := New(server.URL, "key", "secret")
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		_, err := b.AuthenticatedRequestContext(ctx, APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := b.AuthenticatedRequestContext(ctx, APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
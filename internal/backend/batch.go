@@ -0,0 +1,36 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// Batch executes the batch request on the given path. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests, whose results are aggregated in the returned response.
+// If a request fails, the response contains results of previous requests and the error is returned.
+func Batch[C, U, T any](ctx context.Context, b woocommerce.Backend, path string, request woocommerce.BatchRequest[C, U]) (*woocommerce.BatchResponse[T], error) {
+	response := &woocommerce.BatchResponse[T]{}
+	for _, chunk := range request.Chunks(woocommerce.MaxBatchSize) {
+		// Execute authenticated request.
+		resp, err := b.AuthenticatedRequestContext(ctx, APITypeRest, http.MethodPost, path, chunk, nil, nil)
+		if err != nil {
+			return response, err
+		}
+
+		// Unmarshal JSON.
+		result := &woocommerce.BatchResponse[T]{}
+		err = json.NewDecoder(resp.Body).Decode(result)
+		resp.Body.Close()
+		if err != nil {
+			return response, fmt.Errorf("[woocommerce-go]: could not unmarshal batch json: %w", err)
+		}
+
+		response.Append(result)
+	}
+
+	return response, nil
+}
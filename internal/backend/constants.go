@@ -1,3 +1,7 @@
 package backend
 
-const TotalCountHeader = "X-WP-Total"
+const (
+	TotalCountHeader = "X-WP-Total"
+	TotalPagesHeader = "X-WP-TotalPages"
+	LinkHeader       = "Link"
+)
@@ -0,0 +1,163 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Limits configures client-side limiting of requests sent to a single API.
+// Zero value of the limits disables limiting.
+type Limits struct {
+	// RequestsPerSecond is the sustained rate of requests. Zero disables rate limiting.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that can be sent at once
+	// when the rate limit was not reached for a while. Defaults to 1.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent requests. Zero means no limit.
+	// A request is in flight until its response body is closed.
+	MaxInFlight int
+}
+
+// LimiterStats holds metrics of the limiter of a single API.
+type LimiterStats struct {
+	// Requests is the number of requests that passed the limiter.
+	Requests int64
+	// QueueTime is the total time that requests spent waiting in the limiter.
+	QueueTime time.Duration
+	// MaxQueueTime is the longest time a single request spent waiting in the limiter.
+	MaxQueueTime time.Duration
+	// InFlight is the number of requests that are currently in flight.
+	InFlight int
+}
+
+// limiter is a token bucket rate limiter combined with a semaphore
+// that limits the number of concurrent requests.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  LimiterStats
+
+	// sem is nil if the number of concurrent requests is not limited.
+	sem chan struct{}
+}
+
+func newLimiter(limits Limits) *limiter {
+	burst := float64(limits.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &limiter{
+		rate:   limits.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+	if limits.MaxInFlight > 0 {
+		l.sem = make(chan struct{}, limits.MaxInFlight)
+	}
+	return l
+}
+
+// wait blocks until the request is allowed to be sent or the context is done.
+// If the error is nil, caller must call the returned release function once the request is done.
+func (l *limiter) wait(ctx context.Context) (release func(), queueTime time.Duration, err error) {
+	start := time.Now()
+
+	// Acquire a slot for the concurrent request.
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, time.Since(start), ctx.Err()
+		}
+	}
+
+	// Take a token from the bucket.
+	if err := l.take(ctx); err != nil {
+		if l.sem != nil {
+			<-l.sem
+		}
+		return nil, time.Since(start), err
+	}
+
+	queueTime = time.Since(start)
+	l.mu.Lock()
+	l.stats.Requests++
+	l.stats.QueueTime += queueTime
+	if queueTime > l.stats.MaxQueueTime {
+		l.stats.MaxQueueTime = queueTime
+	}
+	l.stats.InFlight++
+	l.mu.Unlock()
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.stats.InFlight--
+			l.mu.Unlock()
+
+			if l.sem != nil {
+				<-l.sem
+			}
+		})
+	}
+	return release, queueTime, nil
+}
+
+// take reserves a token and waits until it becomes available.
+func (l *limiter) take(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// Reserve the token. Number of tokens can go negative,
+	// which makes subsequent requests wait for their turn.
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if err := sleep(ctx, delay); err != nil {
+		// Return the reserved token.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (l *limiter) snapshot() LimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// releaseBody calls the release function when the body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseBody) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
@@ -0,0 +1,98 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackend_MaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	b := New(server.URL, "key", "secret", WithLimits(APITypeRest, Limits{MaxInFlight: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := b.AuthenticatedRequest(APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+
+	stats := b.LimiterStats(APITypeRest)
+	if stats.Requests != 6 {
+		t.Fatalf("expected 6 requests, got %d", stats.Requests)
+	}
+	if stats.InFlight != 0 {
+		t.Fatalf("expected no requests in flight, got %d", stats.InFlight)
+	}
+	if stats.QueueTime == 0 {
+		t.Fatal("expected requests to spend time in queue")
+	}
+}
+
+func TestLimiter_Rate(t *testing.T) {
+	l := newLimiter(Limits{RequestsPerSecond: 100, Burst: 1})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		release, _, err := l.wait(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+
+	// The first request passes immediately, the others wait 10ms each.
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestLimiter_Canceled(t *testing.T) {
+	l := newLimiter(Limits{RequestsPerSecond: 1, MaxInFlight: 1})
+
+	release, _, err := l.wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err = l.wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
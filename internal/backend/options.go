@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures the Backend. Options are passed to the New function.
+type Option func(*options)
+
+type options struct {
+	httpClient  *http.Client
+	timeout     *time.Duration
+	transport   http.RoundTripper
+	userAgent   string
+	baseHeaders map[string]string
+	retryPolicy RetryPolicy
+	limits      map[APIType]Limits
+
+	// authenticator creates the authenticator from credentials passed to New.
+	authenticator      func(consumerKey, consumerSecret string) Authenticator
+	storeAuthenticator Authenticator
+}
+
+// WithHTTPClient sets the HTTP client that is used for executing requests.
+// The client is not modified. If WithTimeout or WithTransport are also passed,
+// they are applied to a copy of the client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client.
+// Zero timeout means no timeout. Default timeout is one minute.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = &timeout
+	}
+}
+
+// WithTransport sets the transport of the HTTP client. It can be used to
+// configure proxies, custom TLS settings or to stub responses in tests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+// By default, the header is sent empty, because go's default one is blocked by some hosting providers.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithBaseHeaders sets headers that are sent with every request.
+// Headers passed to a single request take precedence over base headers.
+// A User-Agent base header takes precedence over WithUserAgent.
+func WithBaseHeaders(headers map[string]string) Option {
+	return func(o *options) {
+		if o.baseHeaders == nil {
+			o.baseHeaders = make(map[string]string, len(headers))
+		}
+		for key, value := range headers {
+			o.baseHeaders[key] = value
+		}
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying failed requests.
+// By default, requests are not retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithLimits sets limits for requests to the given API type.
+// Limits are shared between all clients that use the same backend.
+func WithLimits(apiType APIType, limits Limits) Option {
+	return func(o *options) {
+		if o.limits == nil {
+			o.limits = make(map[APIType]Limits)
+		}
+		o.limits[apiType] = limits
+	}
+}
+
+// WithAuthenticator sets the authenticator of REST API requests.
+// Credentials passed to New are ignored in that case.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(o *options) {
+		o.authenticator = func(string, string) Authenticator {
+			return authenticator
+		}
+	}
+}
+
+// WithOAuth1 authenticates REST API requests with one-legged OAuth 1.0a using credentials passed to New.
+// Woocommerce requires it on stores served over plain HTTP.
+func WithOAuth1(signatureMethod SignatureMethod) Option {
+	return func(o *options) {
+		o.authenticator = func(consumerKey, consumerSecret string) Authenticator {
+			return OAuth1{
+				ConsumerKey:     consumerKey,
+				ConsumerSecret:  consumerSecret,
+				SignatureMethod: signatureMethod,
+			}
+		}
+	}
+}
+
+// WithQueryStringAuth authenticates REST API requests by passing credentials passed to New as query parameters.
+func WithQueryStringAuth() Option {
+	return func(o *options) {
+		o.authenticator = func(consumerKey, consumerSecret string) Authenticator {
+			return QueryStringAuth{
+				ConsumerKey:    consumerKey,
+				ConsumerSecret: consumerSecret,
+			}
+		}
+	}
+}
+
+// WithStoreAuthenticator sets the authenticator of Store API requests, which are not authenticated by default.
+// Authenticating Store API requests as a WordPress user, for instance with JWTAuth,
+// makes the cart act as the cart of a logged-in customer.
+func WithStoreAuthenticator(authenticator Authenticator) Option {
+	return func(o *options) {
+		o.storeAuthenticator = authenticator
+	}
+}
+
+// newHTTPClient builds the HTTP client that is shared by all requests of the backend.
+func (o *options) newHTTPClient() *http.Client {
+	client := &http.Client{
+		Timeout: timeoutDuration,
+	}
+	if o.httpClient != nil {
+		// Copy the client so that the passed one is not modified.
+		c := *o.httpClient
+		client = &c
+	}
+
+	if o.timeout != nil {
+		client.Timeout = *o.timeout
+	}
+	if o.transport != nil {
+		client.Transport = o.transport
+	}
+
+	return client
+}
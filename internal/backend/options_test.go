@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNew_Options(t *testing.T) {
+	var got *http.Request
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	httpClient := &http.Client{Timeout: time.Second}
+	b := New("https://example.com", "key", "secret",
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithTransport(transport),
+		WithUserAgent("woocommerce-go-test"),
+		WithBaseHeaders(map[string]string{"X-Base": "base", "X-Override": "base"}),
+	)
+
+	if httpClient.Timeout != time.Second || httpClient.Transport != nil {
+		t.Fatal("passed http client was modified")
+	}
+	if b.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("expected timeout 5s, got %s", b.httpClient.Timeout)
+	}
+
+	resp, err := b.AuthenticatedRequest(APITypeRest, http.MethodGet, "/orders", nil, nil, map[string]string{"X-Override": "request"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if ua := got.Header.Get("User-Agent"); ua != "woocommerce-go-test" {
+		t.Fatalf("expected user agent woocommerce-go-test, got %q", ua)
+	}
+	if h := got.Header.Get("X-Base"); h != "base" {
+		t.Fatalf("expected base header, got %q", h)
+	}
+	if h := got.Header.Get("X-Override"); h != "request" {
+		t.Fatalf("expected request header to override base header, got %q", h)
+	}
+}
+
+func TestNew_BaseUserAgent(t *testing.T) {
+	var got *http.Request
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	b := New("https://example.com", "key", "secret",
+		WithTransport(transport),
+		WithBaseHeaders(map[string]string{"User-Agent": "erp-sync/1.0"}),
+	)
+	resp, err := b.AuthenticatedRequest(APITypeRest, http.MethodGet, "/orders", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if ua := got.Header.Get("User-Agent"); ua != "erp-sync/1.0" {
+		t.Fatalf("expected user agent from base headers, got %q", ua)
+	}
+}
@@ -0,0 +1,87 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// ListPage executes a list request and returns a page with decoded items and pagination values from the headers.
+// Parameters should contain page and per_page values.
+func ListPage[T any](ctx context.Context, b woocommerce.Backend, path string, parameters woocommerce.Parameters) (*woocommerce.Page[T], error) {
+	// Execute authenticated request.
+	resp, err := b.AuthenticatedRequestContext(ctx, APITypeRest, http.MethodGet, path, nil, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	page := &woocommerce.Page[T]{}
+	err = json.NewDecoder(resp.Body).Decode(&page.Items)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal page json: %w", err)
+	}
+
+	// Parse pagination values.
+	var perPage int
+	if parameters != nil {
+		values := parameters.Values()
+		page.Number, _ = strconv.Atoi(values.Get("page"))
+		perPage, _ = strconv.Atoi(values.Get("per_page"))
+	}
+	if page.Number == 0 {
+		page.Number = 1
+	}
+	if page.Total, err = intHeader(resp, TotalCountHeader); err != nil {
+		return nil, err
+	}
+	if page.TotalPages, err = intHeader(resp, TotalPagesHeader); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.Header.Get(LinkHeader) != "":
+		page.HasNext = hasNextLink(resp.Header.Values(LinkHeader))
+	case resp.Header.Get(TotalPagesHeader) != "":
+		page.HasNext = page.Number < page.TotalPages
+	default:
+		// Without pagination headers, a full page means there might be more items.
+		page.HasNext = perPage > 0 && len(page.Items) == perPage
+	}
+
+	return page, nil
+}
+
+func intHeader(resp *http.Response, header string) (int, error) {
+	s := resp.Header.Get(header)
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("[woocommerce-go]: could not parse %s header: %w", header, err)
+	}
+	return v, nil
+}
+
+// hasNextLink reports whether Link headers contain a link with relation type next.
+func hasNextLink(headers []string) bool {
+	for _, header := range headers {
+		for _, link := range strings.Split(header, ",") {
+			for _, param := range strings.Split(link, ";")[1:] {
+				param = strings.ReplaceAll(strings.TrimSpace(param), " ", "")
+				if strings.EqualFold(param, `rel="next"`) || strings.EqualFold(param, "rel=next") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
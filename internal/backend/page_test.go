@@ -0,0 +1,33 @@
+package backend
+
+import "testing"
+
+func TestHasNextLink(t *testing.T) {
+	cases := []struct {
+		headers  []string
+		expected bool
+	}{
+		{
+			headers:  []string{`<https://example.com/wp-json/wc/v3/orders?page=1>; rel="prev", <https://example.com/wp-json/wc/v3/orders?page=3>; rel="next"`},
+			expected: true,
+		},
+		{
+			headers:  []string{`<https://example.com/wp-json/wc/v3/orders?page=1>; rel="prev"`},
+			expected: false,
+		},
+		{
+			headers:  []string{`<https://example.com/wp-json/wc/v3/orders?page=1>; rel="prev"`, `<https://example.com/wp-json/wc/v3/orders?page=3>; rel=next`},
+			expected: true,
+		},
+		{
+			headers:  nil,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		if got := hasNextLink(c.headers); got != c.expected {
+			t.Errorf("hasNextLink(%q) = %v, expected %v", c.headers, got, c.expected)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateParameterFormat is the ISO8601 format of dates in query parameters.
+const DateParameterFormat = "2006-01-02T15:04:05"
+
+// SetString sets the parameter with the given key if the value is not empty.
+func SetString(values url.Values, key, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+// SetInt sets the parameter with the given key if the value is not zero.
+func SetInt(values url.Values, key string, value int) {
+	if value != 0 {
+		values.Set(key, strconv.Itoa(value))
+	}
+}
+
+// SetInts sets the parameter with the given key to a comma separated list of values if the list is not empty.
+func SetInts(values url.Values, key string, list []int) {
+	if len(list) == 0 {
+		return
+	}
+
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = strconv.Itoa(v)
+	}
+	values.Set(key, strings.Join(s, ","))
+}
+
+// SetStrings sets the parameter with the given key to a comma separated list of values if the list is not empty.
+func SetStrings[S ~string](values url.Values, key string, list []S) {
+	if len(list) == 0 {
+		return
+	}
+
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = string(v)
+	}
+	values.Set(key, strings.Join(s, ","))
+}
+
+// SetDate sets the date parameter with the given key if the date is not zero.
+// If gmt is true, the date is converted to UTC. Otherwise, it is formatted in its own location.
+func SetDate(values url.Values, key string, t time.Time, gmt bool) {
+	if t.IsZero() {
+		return
+	}
+	if gmt {
+		t = t.UTC()
+	}
+	values.Set(key, t.Format(DateParameterFormat))
+}
+
+// Overlap returns the first value that is present in both lists and whether such value exists.
+func Overlap(a, b []int) (int, bool) {
+	set := make(map[int]struct{}, len(a))
+	for _, v := range a {
+		set[v] = struct{}{}
+	}
+	for _, v := range b {
+		if _, ok := set[v]; ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
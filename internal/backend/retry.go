@@ -0,0 +1,191 @@
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// IdempotencyKeyHeader is the header that carries the idempotency key of retried POST requests.
+// The key is the same for all attempts of a single request, so that the server (or a proxy in front of it)
+// can detect duplicated requests.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RetryPolicy configures retrying of failed requests.
+// Zero value of the policy disables retrying.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Values lower than 2 disable retrying.
+	MaxAttempts int
+	// MinBackoff is the base delay before the first retry. Each following delay is doubled.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. It does not apply to delays from the Retry-After header.
+	MaxBackoff time.Duration
+	// StatusCodes are response status codes that are retried.
+	// If empty, 429, 502, 503 and 504 are retried.
+	StatusCodes []int
+	// RetryPost enables retrying of POST requests, which are not idempotent.
+	// Every attempt of a retried POST request carries the same Idempotency-Key header.
+	// To lower the chance of creating duplicated objects, POST requests are only retried on
+	// connection errors and on 429 and 503 responses, which mean the server did not process the request.
+	RetryPost bool
+	// OnAttempt is called after every attempt. It can be used for logging and metrics.
+	OnAttempt func(Attempt)
+}
+
+// Attempt describes a single attempt of a request.
+type Attempt struct {
+	// Number is the number of the attempt, starting with 1.
+	Number int
+	Method string
+	URL    string
+	// StatusCode is the status code of the response or zero if no response was received.
+	StatusCode int
+	// Err is the error that occurred while executing the request.
+	Err error
+	// Retry is true if the request is going to be retried.
+	Retry bool
+	// Delay is the time to wait before the next attempt.
+	Delay time.Duration
+	// QueueTime is the time the attempt spent waiting in the limiter.
+	QueueTime time.Duration
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults
+// for woocommerce stores on shared hosting.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// shouldRetry reports whether the attempt should be retried and how long to wait before the next attempt.
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, resp *http.Response, err error) (bool, time.Duration) {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false, 0
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	case http.MethodPost:
+		if !p.RetryPost {
+			return false, 0
+		}
+	default:
+		return false, 0
+	}
+
+	// Connection errors are always retried.
+	if err != nil {
+		return true, p.backoff(attempt)
+	}
+
+	if !p.retryStatusCode(method, resp.StatusCode) {
+		return false, 0
+	}
+
+	if delay, ok := retryAfter(resp); ok {
+		return true, delay
+	}
+	return true, p.backoff(attempt)
+}
+
+func (p RetryPolicy) retryStatusCode(method string, statusCode int) bool {
+	if method == http.MethodPost {
+		return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+	}
+
+	codes := p.StatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryStatusCodes
+	}
+	for _, code := range codes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns exponential backoff with jitter for the given attempt.
+// The returned delay is in range [d/2, d), where d is the exponential delay.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if p.MinBackoff <= 0 {
+		return 0
+	}
+
+	d := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	half := int64(d / 2)
+	if half <= 0 {
+		return time.Duration(d)
+	}
+	return time.Duration(half + mathrand.Int63n(half))
+}
+
+// retryAfter parses the Retry-After header of the response.
+// The header can either hold number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// randomToken generates a random hex encoded token. It is used for idempotency keys and nonces.
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,139 @@
+package backend
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBackend_Retry(t *testing.T) {
+	cases := []struct {
+		name             string
+		method           string
+		retryPost        bool
+		failures         int
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{
+			name:             "put retried",
+			method:           http.MethodPut,
+			failures:         2,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "attempts exhausted",
+			method:           http.MethodGet,
+			failures:         5,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:             "post not retried",
+			method:           http.MethodPost,
+			failures:         1,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:             "post retried",
+			method:           http.MethodPost,
+			retryPost:        true,
+			failures:         1,
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var attempts int
+			var keys []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"name":"test"}` {
+					t.Errorf("attempt %d: unexpected body %q", attempts, string(body))
+				}
+
+				if attempts <= c.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			var hookCalls int
+			b := New(server.URL, "key", "secret", WithRetryPolicy(RetryPolicy{
+				MaxAttempts: 3,
+				MinBackoff:  time.Millisecond,
+				MaxBackoff:  time.Millisecond,
+				RetryPost:   c.retryPost,
+				OnAttempt: func(a Attempt) {
+					hookCalls++
+					if a.Number != hookCalls {
+						t.Errorf("expected attempt number %d, got %d", hookCalls, a.Number)
+					}
+				},
+			}))
+
+			body := map[string]string{"name": "test"}
+			resp, err := b.AuthenticatedRequest(APITypeRest, c.method, "/products", body, nil, nil)
+			if resp == nil {
+				t.Fatalf("expected response, got error %v", err)
+			}
+			if resp.StatusCode != c.expectedStatus {
+				t.Fatalf("expected status %d, got %d", c.expectedStatus, resp.StatusCode)
+			}
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+
+			if attempts != c.expectedAttempts {
+				t.Fatalf("expected %d attempts, got %d", c.expectedAttempts, attempts)
+			}
+			if hookCalls != c.expectedAttempts {
+				t.Fatalf("expected %d hook calls, got %d", c.expectedAttempts, hookCalls)
+			}
+
+			if c.retryPost {
+				if keys[0] == "" || keys[0] != keys[len(keys)-1] {
+					t.Fatalf("expected the same idempotency key on all attempts, got %v", keys)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{value: "", ok: false},
+		{value: "3", expected: 3 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Mon, 02 Jan 2006 15:04:05 GMT", expected: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Retry-After", c.value)
+
+			d, ok := retryAfter(resp)
+			if ok != c.ok || d != c.expected {
+				t.Fatalf("expected (%s, %t), got (%s, %t)", c.expected, c.ok, d, ok)
+			}
+		})
+	}
+}
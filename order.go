@@ -87,6 +87,19 @@ type OrderShipping struct {
 	MetaData []MetaData `json:"meta_data"`
 }
 
+type OrderFee struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	TaxClass  string `json:"tax_class"`
+	TaxStatus string `json:"tax_status"`
+	// Total is the line total after discounts.
+	Total Float `json:"total"`
+	// TotalTax is the line total tax after discounts.
+	TotalTax Float      `json:"total_tax"`
+	Taxes    []OrderTax `json:"taxes"`
+	MetaData []MetaData `json:"meta_data"`
+}
+
 type OrderCoupon struct {
 	ID          int        `json:"ID,omitempty"`
 	Code        string     `json:"code"`
@@ -95,11 +108,13 @@ type OrderCoupon struct {
 	MetaData    []MetaData `json:"meta_data,omitempty"`
 }
 
+// OrderRefund is a short summary of a refund that is included in the order.
+// Refunds can be retrieved in full with the order refunds client.
 type OrderRefund struct {
-	ID     int
-	Reason string
-	// Total is the refund total.
-	Total Float
+	ID     int    `json:"id"`
+	Reason string `json:"reason"`
+	// Total is the refund total. It is negative.
+	Total Float `json:"total"`
 }
 
 // Order is the order object that the API returns.
@@ -149,6 +164,7 @@ type Order struct {
 	LineItems     []OrderItem     `json:"line_items"`
 	TaxLines      []OrderTax      `json:"tax_lines"`
 	ShippingLines []OrderShipping `json:"shipping_lines"`
+	FeeLines      []OrderFee      `json:"fee_lines"`
 	CouponLines   []OrderCoupon   `json:"coupon_lines"`
 	Refunds       []OrderRefund   `json:"refunds"`
 }
@@ -1,6 +1,7 @@
 package order
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/zerodays/woocommerce-go"
@@ -10,29 +11,42 @@ import (
 )
 
 const (
-	pathList = "/orders"
-	pathEdit = "/orders/%d"
+	pathList  = "/orders"
+	pathEdit  = "/orders/%d"
+	pathBatch = "/orders/batch"
 )
 
 // Client is the API client used for working with orders.
 // It should not be initialized directly. Use client.API instead.
 type Client struct {
-	backend *backend.Backend
+	backend woocommerce.Backend
+
+	// Refunds is the client used for working with refunds of orders.
+	Refunds *RefundClient
+	// Notes is the client used for working with notes of orders.
+	Notes *NoteClient
 }
 
 // New creates a new client for orders.
 // It should not be called directly.
 // Instead, client.API should be used.
-func New(backend *backend.Backend) *Client {
+func New(backend woocommerce.Backend) *Client {
 	return &Client{
 		backend: backend,
+		Refunds: &RefundClient{backend: backend},
+		Notes:   &NoteClient{backend: backend},
 	}
 }
 
 // List returns a list of orders with given parameters and total order count.
 func (c Client) List(parameters woocommerce.Parameters) ([]*woocommerce.Order, int, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c Client) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]*woocommerce.Order, int, error) {
 	// Execute authenticated request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -58,10 +72,23 @@ func (c Client) List(parameters woocommerce.Parameters) ([]*woocommerce.Order, i
 	return orders, count, nil
 }
 
+// Pager returns a pager that walks all pages of orders with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.Order] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.Order], error) {
+		return backend.ListPage[*woocommerce.Order](ctx, c.backend, pathList, parameters)
+	}, options...)
+}
+
 // Create creates a new order.
 func (c Client) Create(orderCreate *woocommerce.OrderCreate) (*woocommerce.Order, error) {
+	return c.CreateContext(context.Background(), orderCreate)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c Client) CreateContext(ctx context.Context, orderCreate *woocommerce.OrderCreate) (*woocommerce.Order, error) {
 	// Execute authenticated request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodPost, pathList, orderCreate, nil, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, pathList, orderCreate, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -77,11 +104,16 @@ func (c Client) Create(orderCreate *woocommerce.OrderCreate) (*woocommerce.Order
 	return order, nil
 }
 
-// Update updates the order with a given ID
+// Update updates the order with a given ID. Only fields that are set in the update are changed.
 func (c Client) Update(orderID int, orderUpdate woocommerce.OrderUpdate) (*woocommerce.Order, error) {
+	return c.UpdateContext(context.Background(), orderID, orderUpdate)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c Client) UpdateContext(ctx context.Context, orderID int, orderUpdate woocommerce.OrderUpdate) (*woocommerce.Order, error) {
 	// Execute authenticated request.
 	path := fmt.Sprintf(pathEdit, orderID)
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodPut, path, orderUpdate, nil, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, orderUpdate, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +128,66 @@ func (c Client) Update(orderID int, orderUpdate woocommerce.OrderUpdate) (*wooco
 
 	return order, nil
 }
+
+// Retrieve retrieves the order with a given ID.
+func (c Client) Retrieve(orderID int) (*woocommerce.Order, error) {
+	return c.RetrieveContext(context.Background(), orderID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c Client) RetrieveContext(ctx context.Context, orderID int) (*woocommerce.Order, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathEdit, orderID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	order := &woocommerce.Order{}
+	err = json.NewDecoder(resp.Body).Decode(&order)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order json: %w", err)
+	}
+
+	return order, nil
+}
+
+// Delete deletes the order with a given ID and returns the deleted order.
+// If force is false, the order is moved to trash. Otherwise, it is permanently deleted.
+func (c Client) Delete(orderID int, force bool) (*woocommerce.Order, error) {
+	return c.DeleteContext(context.Background(), orderID, force)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c Client) DeleteContext(ctx context.Context, orderID int, force bool) (*woocommerce.Order, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathEdit, orderID)
+	parameters := woocommerce.BaseParameters{"force": {strconv.FormatBool(force)}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	order := &woocommerce.Order{}
+	err = json.NewDecoder(resp.Body).Decode(&order)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order json: %w", err)
+	}
+
+	return order, nil
+}
+
+// Batch creates, updates and deletes multiple orders. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Orders that could not be processed are reported by the Err method of the response.
+func (c Client) Batch(request woocommerce.BatchRequest[woocommerce.OrderCreate, woocommerce.OrderUpdate]) (*woocommerce.BatchResponse[*woocommerce.Order], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c Client) BatchContext(ctx context.Context, request woocommerce.BatchRequest[woocommerce.OrderCreate, woocommerce.OrderUpdate]) (*woocommerce.BatchResponse[*woocommerce.Order], error) {
+	return backend.Batch[woocommerce.OrderCreate, woocommerce.OrderUpdate, *woocommerce.Order](ctx, c.backend, pathBatch, request)
+}
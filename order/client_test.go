@@ -1,10 +1,14 @@
 package order
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
 
+	"github.com/zerodays/woocommerce-go"
 	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
 )
 
 var baseURL, consumerKey, consumerSecret string
@@ -14,6 +18,21 @@ func TestMain(m *testing.M) {
 	consumerKey = os.Getenv("CONSUMER_KEY")
 	consumerSecret = os.Getenv("CONSUMER_SECRET")
 
+	// Use the fake server if a live store is not configured.
+	if baseURL == "" {
+		srv := wctest.NewServer()
+		for i := 0; i < 12; i++ {
+			if _, err := srv.Add(wctest.ResourceOrders, map[string]interface{}{"status": "processing"}); err != nil {
+				panic(err)
+			}
+		}
+		baseURL, consumerKey, consumerSecret = srv.URL, srv.ConsumerKey, srv.ConsumerSecret
+
+		code := m.Run()
+		srv.Close()
+		os.Exit(code)
+	}
+
 	os.Exit(m.Run())
 }
 
@@ -30,3 +49,89 @@ func TestClient_List(t *testing.T) {
 		t.Logf("%#v", orders[0])
 	}
 }
+
+func TestClient_Pager(t *testing.T) {
+	b := backend.New(baseURL, consumerKey, consumerSecret)
+	client := New(b)
+	_, total, err := client.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orders, err := client.Pager(nil, woocommerce.WithPageSize(5), woocommerce.WithPrefetch(2)).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != total {
+		t.Errorf("expected %d orders, got %d", total, len(orders))
+	}
+}
+
+func TestClient_Lifecycle(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	productID, err := srv.Add(wctest.ResourceProducts, map[string]interface{}{"name": "Shirt", "regular_price": "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	created, err := client.Create(&woocommerce.OrderCreate{
+		Billing:  woocommerce.OrderCreateBilling{FirstName: "John", Email: "john@example.com"},
+		Items:    []woocommerce.OrderCreateItem{{ProductID: productID, Quantity: 1}},
+		MetaData: []woocommerce.OrderCreateMetadata{{Key: "source", Value: "test"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update only some fields and lines.
+	updated, err := client.Update(created.ID, woocommerce.OrderUpdate{
+		Status:        woocommerce.Ptr(woocommerce.OrderStatusOnHold),
+		Billing:       &woocommerce.OrderUpdateAddress{Email: woocommerce.Ptr("jane@example.com")},
+		TransactionID: woocommerce.Ptr("tx-1"),
+		LineItems: []woocommerce.OrderLineItemUpdate{
+			woocommerce.RemoveOrderLineItem(created.LineItems[0].ID),
+			{ProductID: woocommerce.Ptr(productID), Quantity: woocommerce.Ptr(3)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status != woocommerce.OrderStatusOnHold || updated.TransactionID != "tx-1" {
+		t.Errorf("unexpected status or transaction ID: %s, %s", updated.Status, updated.TransactionID)
+	}
+	if updated.Billing.FirstName != "John" || updated.Billing.Email != "jane@example.com" {
+		t.Errorf("unexpected billing: %+v", updated.Billing)
+	}
+	if len(updated.LineItems) != 1 || updated.LineItems[0].Quantity != 3 {
+		t.Errorf("unexpected line items: %+v", updated.LineItems)
+	}
+	if len(updated.MetaData) != 1 {
+		t.Errorf("expected meta data to be kept, got %+v", updated.MetaData)
+	}
+
+	retrieved, err := client.Retrieve(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.TransactionID != "tx-1" {
+		t.Errorf("expected retrieved order to be updated, got %+v", retrieved)
+	}
+
+	trashed, err := client.Delete(created.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed.Status != woocommerce.OrderStatusTrash {
+		t.Errorf("expected order in trash, got %s", trashed.Status)
+	}
+
+	if _, err := client.Delete(created.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	var wcErr *woocommerce.Error
+	if _, err := client.Retrieve(created.ID); !errors.As(err, &wcErr) {
+		t.Errorf("expected error for deleted order, got %v", err)
+	}
+}
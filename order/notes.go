@@ -0,0 +1,130 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathNotes    = "/orders/%d/notes"
+	pathNoteEdit = "/orders/%d/notes/%d"
+)
+
+// NoteClient is the API client used for working with order notes.
+// It should not be initialized directly. Use Client.Notes instead.
+type NoteClient struct {
+	backend woocommerce.Backend
+}
+
+// List returns notes of the order with the given type. Empty type lists notes of any type.
+func (c NoteClient) List(orderID int, noteType woocommerce.OrderNoteType) ([]*woocommerce.OrderNote, error) {
+	return c.ListContext(context.Background(), orderID, noteType)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c NoteClient) ListContext(ctx context.Context, orderID int, noteType woocommerce.OrderNoteType) ([]*woocommerce.OrderNote, error) {
+	var parameters woocommerce.Parameters
+	if noteType != "" {
+		parameters = woocommerce.BaseParameters{"type": {string(noteType)}}
+	}
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathNotes, orderID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var notes []*woocommerce.OrderNote
+	err = json.NewDecoder(resp.Body).Decode(&notes)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order notes json: %w", err)
+	}
+
+	return notes, nil
+}
+
+// Retrieve retrieves the note with a given ID of the order.
+func (c NoteClient) Retrieve(orderID, noteID int) (*woocommerce.OrderNote, error) {
+	return c.RetrieveContext(context.Background(), orderID, noteID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c NoteClient) RetrieveContext(ctx context.Context, orderID, noteID int) (*woocommerce.OrderNote, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathNoteEdit, orderID, noteID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	note := &woocommerce.OrderNote{}
+	err = json.NewDecoder(resp.Body).Decode(&note)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order note json: %w", err)
+	}
+
+	return note, nil
+}
+
+// Create adds a new note to the order.
+// If CustomerNote of the note is set, woocommerce sends the note to the customer by email.
+func (c NoteClient) Create(orderID int, noteCreate *woocommerce.OrderNoteCreate) (*woocommerce.OrderNote, error) {
+	return c.CreateContext(context.Background(), orderID, noteCreate)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c NoteClient) CreateContext(ctx context.Context, orderID int, noteCreate *woocommerce.OrderNoteCreate) (*woocommerce.OrderNote, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathNotes, orderID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, path, noteCreate, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	note := &woocommerce.OrderNote{}
+	err = json.NewDecoder(resp.Body).Decode(&note)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order note json: %w", err)
+	}
+
+	return note, nil
+}
+
+// Delete permanently deletes the note with a given ID of the order and returns the deleted note.
+// Order notes do not support trash.
+func (c NoteClient) Delete(orderID, noteID int) (*woocommerce.OrderNote, error) {
+	return c.DeleteContext(context.Background(), orderID, noteID)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c NoteClient) DeleteContext(ctx context.Context, orderID, noteID int) (*woocommerce.OrderNote, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathNoteEdit, orderID, noteID)
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	note := &woocommerce.OrderNote{}
+	err = json.NewDecoder(resp.Body).Decode(&note)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal order note json: %w", err)
+	}
+
+	return note, nil
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestNoteClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	orderID, err := srv.Add(wctest.ResourceOrders, map[string]interface{}{"status": "processing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	private, err := client.Notes.Create(orderID, &woocommerce.OrderNoteCreate{Note: "Checked stock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if private.Author != "WooCommerce" || private.CustomerNote || private.DateCreatedGMT.IsZero() {
+		t.Errorf("unexpected private note: %+v", private)
+	}
+
+	customer, err := client.Notes.Create(orderID, &woocommerce.OrderNoteCreate{Note: "Shipped", CustomerNote: true, AddedByUser: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Author == "WooCommerce" || !customer.CustomerNote {
+		t.Errorf("unexpected customer note: %+v", customer)
+	}
+
+	notes, err := client.Notes.List(orderID, woocommerce.OrderNoteTypeCustomer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes) != 1 || notes[0].ID != customer.ID {
+		t.Errorf("expected only the customer note, got %+v", notes)
+	}
+
+	retrieved, err := client.Notes.Retrieve(orderID, private.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.Note != "Checked stock" {
+		t.Errorf("unexpected retrieved note: %+v", retrieved)
+	}
+
+	if _, err := client.Notes.Delete(orderID, private.ID); err != nil {
+		t.Fatal(err)
+	}
+	notes, err = client.Notes.List(orderID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes) != 1 {
+		t.Errorf("expected 1 note after delete, got %d", len(notes))
+	}
+}
@@ -0,0 +1,123 @@
+package order
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// OrderBy is the attribute by which orders are sorted.
+type OrderBy string
+
+const (
+	OrderByDate     OrderBy = "date"
+	OrderByID       OrderBy = "id"
+	OrderByInclude  OrderBy = "include"
+	OrderByTitle    OrderBy = "title"
+	OrderBySlug     OrderBy = "slug"
+	OrderByModified OrderBy = "modified"
+)
+
+// ListParams are parameters for listing orders. Zero values are omitted from the request,
+// so woocommerce defaults are used for them.
+//
+// Dates are formatted in the location of the time values, which should be the timezone of the store.
+// If DatesAreGMT is set, dates are converted to UTC and woocommerce compares them with GMT dates of orders.
+type ListParams struct {
+	woocommerce.PageParams
+
+	// Search limits results to orders matching the string.
+	Search string
+	// Status limits results to orders with any of the given statuses. By default, orders with any status are listed.
+	Status []woocommerce.OrderStatus
+	// Customer limits results to orders of the customer with the given ID.
+	// Pointer to 0 limits results to orders of guests.
+	Customer *int
+	// Product limits results to orders that contain the product with the given ID.
+	Product int
+
+	After          time.Time
+	Before         time.Time
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+	DatesAreGMT    bool
+
+	Include []int
+	Exclude []int
+	// Parent limits results to orders with given parent IDs.
+	Parent []int
+
+	OrderBy OrderBy
+	Order   woocommerce.SortOrder
+}
+
+func (p ListParams) Values() url.Values {
+	values := p.PageParams.Values()
+
+	backend.SetString(values, "search", p.Search)
+	backend.SetStrings(values, "status", p.Status)
+	if p.Customer != nil {
+		values.Set("customer", strconv.Itoa(*p.Customer))
+	}
+	backend.SetInt(values, "product", p.Product)
+
+	backend.SetDate(values, "after", p.After, p.DatesAreGMT)
+	backend.SetDate(values, "before", p.Before, p.DatesAreGMT)
+	backend.SetDate(values, "modified_after", p.ModifiedAfter, p.DatesAreGMT)
+	backend.SetDate(values, "modified_before", p.ModifiedBefore, p.DatesAreGMT)
+	if p.DatesAreGMT {
+		values.Set("dates_are_gmt", "true")
+	}
+
+	backend.SetInts(values, "include", p.Include)
+	backend.SetInts(values, "exclude", p.Exclude)
+	backend.SetInts(values, "parent", p.Parent)
+
+	backend.SetString(values, "orderby", string(p.OrderBy))
+	backend.SetString(values, "order", string(p.Order))
+	return values
+}
+
+// Validate checks the parameters for values that woocommerce would reject
+// and for combinations that can not match any order.
+func (p ListParams) Validate() error {
+	if err := p.PageParams.Validate(); err != nil {
+		return err
+	}
+	if err := p.Order.Validate(); err != nil {
+		return err
+	}
+
+	switch p.OrderBy {
+	case "", OrderByDate, OrderByID, OrderByInclude, OrderByTitle, OrderBySlug, OrderByModified:
+	default:
+		return fmt.Errorf("%w: unknown orderby value %q", woocommerce.ErrInvalidParameters, p.OrderBy)
+	}
+	if p.OrderBy == OrderByInclude && len(p.Include) == 0 {
+		return fmt.Errorf("%w: orderby include requires include to be set", woocommerce.ErrInvalidParameters)
+	}
+
+	if p.Customer != nil && *p.Customer < 0 {
+		return fmt.Errorf("%w: customer must not be negative, got %d", woocommerce.ErrInvalidParameters, *p.Customer)
+	}
+	if p.Product < 0 {
+		return fmt.Errorf("%w: product must not be negative, got %d", woocommerce.ErrInvalidParameters, p.Product)
+	}
+
+	if !p.After.IsZero() && !p.Before.IsZero() && !p.After.Before(p.Before) {
+		return fmt.Errorf("%w: after must be before before", woocommerce.ErrInvalidParameters)
+	}
+	if !p.ModifiedAfter.IsZero() && !p.ModifiedBefore.IsZero() && !p.ModifiedAfter.Before(p.ModifiedBefore) {
+		return fmt.Errorf("%w: modified_after must be before modified_before", woocommerce.ErrInvalidParameters)
+	}
+
+	if id, ok := backend.Overlap(p.Include, p.Exclude); ok {
+		return fmt.Errorf("%w: order %d is both included and excluded", woocommerce.ErrInvalidParameters, id)
+	}
+
+	return nil
+}
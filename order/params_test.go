@@ -0,0 +1,87 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+func TestListParams_Values(t *testing.T) {
+	guest := 0
+	params := ListParams{
+		PageParams:  woocommerce.PageParams{Page: 2, PerPage: 50},
+		Status:      []woocommerce.OrderStatus{woocommerce.OrderStatusProcessing, woocommerce.OrderStatusOnHold},
+		Customer:    &guest,
+		After:       time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)),
+		DatesAreGMT: true,
+		Include:     []int{1, 2, 3},
+		OrderBy:     OrderByModified,
+		Order:       woocommerce.SortOrderAsc,
+	}
+
+	expected := "after=2023-01-02T02%3A04%3A05&customer=0&dates_are_gmt=true&include=1%2C2%2C3&order=asc&orderby=modified&page=2&per_page=50&status=processing%2Con-hold"
+	if got := params.Values().Encode(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	if got := (ListParams{}).Values().Encode(); got != "" {
+		t.Errorf("expected empty parameters, got %s", got)
+	}
+}
+
+func TestListParams_Validate(t *testing.T) {
+	now := time.Now()
+	negative := -1
+	cases := []struct {
+		name   string
+		params ListParams
+		valid  bool
+	}{
+		{"empty", ListParams{}, true},
+		{"max per page", ListParams{PageParams: woocommerce.PageParams{PerPage: 100}}, true},
+		{"per page too large", ListParams{PageParams: woocommerce.PageParams{PerPage: 101}}, false},
+		{"negative page", ListParams{PageParams: woocommerce.PageParams{Page: -1}}, false},
+		{"invalid order", ListParams{Order: "up"}, false},
+		{"invalid orderby", ListParams{OrderBy: "price"}, false},
+		{"orderby include without include", ListParams{OrderBy: OrderByInclude}, false},
+		{"negative customer", ListParams{Customer: &negative}, false},
+		{"after is after before", ListParams{After: now, Before: now.Add(-time.Hour)}, false},
+		{"date range", ListParams{After: now.Add(-time.Hour), Before: now}, true},
+		{"modified range", ListParams{ModifiedAfter: now, ModifiedBefore: now}, false},
+		{"include and exclude", ListParams{Include: []int{1, 2}, Exclude: []int{2}}, false},
+	}
+
+	for _, c := range cases {
+		err := c.params.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if !c.valid && !errors.Is(err, woocommerce.ErrInvalidParameters) {
+			t.Errorf("%s: expected ErrInvalidParameters, got %v", c.name, err)
+		}
+	}
+}
+
+func TestClient_ListInvalidParams(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request was sent with invalid parameters")
+	}))
+	defer srv.Close()
+
+	client := New(backend.New(srv.URL, "key", "secret"))
+	_, _, err := client.List(ListParams{PageParams: woocommerce.PageParams{PerPage: 101}})
+	if !errors.Is(err, woocommerce.ErrInvalidParameters) {
+		t.Errorf("expected ErrInvalidParameters, got %v", err)
+	}
+
+	_, err = client.Pager(ListParams{Order: "up"}).Collect(context.Background())
+	if !errors.Is(err, woocommerce.ErrInvalidParameters) {
+		t.Errorf("expected ErrInvalidParameters from pager, got %v", err)
+	}
+}
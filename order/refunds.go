@@ -0,0 +1,167 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathRefunds      = "/orders/%d/refunds"
+	pathRefundEdit   = "/orders/%d/refunds/%d"
+	pathRefundsStore = "/refunds"
+)
+
+// RefundClient is the API client used for working with order refunds.
+// It should not be initialized directly. Use Client.Refunds instead.
+type RefundClient struct {
+	backend woocommerce.Backend
+}
+
+// List returns a list of refunds of the order with given parameters and total refund count.
+func (c RefundClient) List(orderID int, parameters woocommerce.Parameters) ([]*woocommerce.Refund, int, error) {
+	return c.ListContext(context.Background(), orderID, parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c RefundClient) ListContext(ctx context.Context, orderID int, parameters woocommerce.Parameters) ([]*woocommerce.Refund, int, error) {
+	return c.list(ctx, fmt.Sprintf(pathRefunds, orderID), parameters)
+}
+
+// ListAll returns a list of refunds of all orders with given parameters and total refund count.
+func (c RefundClient) ListAll(parameters woocommerce.Parameters) ([]*woocommerce.Refund, int, error) {
+	return c.ListAllContext(context.Background(), parameters)
+}
+
+// ListAllContext is the same as ListAll, but it uses the given context for the request.
+func (c RefundClient) ListAllContext(ctx context.Context, parameters woocommerce.Parameters) ([]*woocommerce.Refund, int, error) {
+	return c.list(ctx, pathRefundsStore, parameters)
+}
+
+func (c RefundClient) list(ctx context.Context, path string, parameters woocommerce.Parameters) ([]*woocommerce.Refund, int, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, parameters, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var refunds []*woocommerce.Refund
+	err = json.NewDecoder(resp.Body).Decode(&refunds)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[woocommerce-go]: could not unmarshal refunds json: %w", err)
+	}
+
+	// Get total refund count
+	countStr := resp.Header.Get(backend.TotalCountHeader)
+	var count int
+	if countStr != "" {
+		count, err = strconv.Atoi(countStr)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[woocommerce-go]: could not parse total refund count: %w", err)
+		}
+	}
+
+	return refunds, count, nil
+}
+
+// Pager returns a pager that walks all pages of refunds of the order with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c RefundClient) Pager(orderID int, parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.Refund] {
+	path := fmt.Sprintf(pathRefunds, orderID)
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.Refund], error) {
+		return backend.ListPage[*woocommerce.Refund](ctx, c.backend, path, parameters)
+	}, options...)
+}
+
+// AllPager returns a pager that walks all pages of refunds of all orders with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c RefundClient) AllPager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.Refund] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.Refund], error) {
+		return backend.ListPage[*woocommerce.Refund](ctx, c.backend, pathRefundsStore, parameters)
+	}, options...)
+}
+
+// Retrieve retrieves the refund with a given ID of the order.
+func (c RefundClient) Retrieve(orderID, refundID int) (*woocommerce.Refund, error) {
+	return c.RetrieveContext(context.Background(), orderID, refundID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c RefundClient) RetrieveContext(ctx context.Context, orderID, refundID int) (*woocommerce.Refund, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRefundEdit, orderID, refundID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	refund := &woocommerce.Refund{}
+	err = json.NewDecoder(resp.Body).Decode(&refund)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal refund json: %w", err)
+	}
+
+	return refund, nil
+}
+
+// Create creates a new refund of the order.
+// The payment is refunded through the payment gateway only if APIRefund of the refund is set.
+func (c RefundClient) Create(orderID int, refundCreate *woocommerce.RefundCreate) (*woocommerce.Refund, error) {
+	return c.CreateContext(context.Background(), orderID, refundCreate)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c RefundClient) CreateContext(ctx context.Context, orderID int, refundCreate *woocommerce.RefundCreate) (*woocommerce.Refund, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRefunds, orderID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, path, refundCreate, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	refund := &woocommerce.Refund{}
+	err = json.NewDecoder(resp.Body).Decode(&refund)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal refund json: %w", err)
+	}
+
+	return refund, nil
+}
+
+// Delete permanently deletes the refund with a given ID of the order and returns the deleted refund.
+// Refunds do not support trash. Deleting a refund does not return the money refunded through the payment gateway.
+func (c RefundClient) Delete(orderID, refundID int) (*woocommerce.Refund, error) {
+	return c.DeleteContext(context.Background(), orderID, refundID)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c RefundClient) DeleteContext(ctx context.Context, orderID, refundID int) (*woocommerce.Refund, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRefundEdit, orderID, refundID)
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	refund := &woocommerce.Refund{}
+	err = json.NewDecoder(resp.Body).Decode(&refund)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal refund json: %w", err)
+	}
+
+	return refund, nil
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestRefundClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	productID, err := srv.Add(wctest.ResourceProducts, map[string]interface{}{
+		"name":           "Shirt",
+		"regular_price":  "10",
+		"manage_stock":   true,
+		"stock_quantity": 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	order, err := client.Create(&woocommerce.OrderCreate{
+		Items: []woocommerce.OrderCreateItem{{ProductID: productID, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refund, err := client.Refunds.Create(order.ID, &woocommerce.RefundCreate{
+		Reason: "Damaged",
+		LineItems: []woocommerce.RefundLineItemCreate{
+			{ID: order.LineItems[0].ID, Quantity: 1, RefundTotal: 10},
+		},
+		RestockItems: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund.Amount != 10 || refund.Reason != "Damaged" || refund.RefundedPayment {
+		t.Errorf("unexpected refund: %+v", refund)
+	}
+	if len(refund.LineItems) != 1 || refund.LineItems[0].Quantity != -1 || refund.LineItems[0].RefundTotal != 10 {
+		t.Errorf("unexpected refund line items: %+v", refund.LineItems)
+	}
+
+	var product struct {
+		StockQuantity int `json:"stock_quantity"`
+	}
+	srv.Get(wctest.ResourceProducts, productID, &product)
+	if product.StockQuantity != 6 {
+		t.Errorf("expected restocked quantity 6, got %d", product.StockQuantity)
+	}
+
+	order, err = client.Retrieve(order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(order.Refunds) != 1 || order.Refunds[0].ID != refund.ID || order.Refunds[0].Total != -10 {
+		t.Errorf("unexpected order refunds: %+v", order.Refunds)
+	}
+
+	// Refunding more than the remaining amount fails.
+	if _, err := client.Refunds.Create(order.ID, &woocommerce.RefundCreate{Amount: 15}); err == nil {
+		t.Error("expected error for refund larger than the order total")
+	}
+
+	refunds, total, err := client.Refunds.ListAll(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(refunds) != 1 || refunds[0].ParentID != order.ID {
+		t.Errorf("unexpected refunds of all orders: %d, %+v", total, refunds)
+	}
+
+	if _, err := client.Refunds.Delete(order.ID, refund.ID); err != nil {
+		t.Fatal(err)
+	}
+	refunds, _, err = client.Refunds.List(order.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refunds) != 0 {
+		t.Errorf("expected no refunds after delete, got %d", len(refunds))
+	}
+}
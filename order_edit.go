@@ -1,5 +1,7 @@
 package woocommerce
 
+import "encoding/json"
+
 type OrderCreateBilling struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
@@ -58,6 +60,169 @@ type OrderCreate struct {
 	CouponLines        []OrderCoupon         `json:"coupon_lines,omitempty"`
 }
 
+// OrderUpdate is a partial update of an order. Only fields that are set are sent,
+// so fields that are not set keep their current values:
+// pointer fields are sent when they are not nil and slices when they are not empty.
+//
+// Lines are matched by their IDs. Lines without an ID are added to the order,
+// lines with an ID are modified and lines created with RemoveOrderLineItem,
+// RemoveOrderShippingLine, RemoveOrderFeeLine or RemoveOrderCouponLine are removed.
 type OrderUpdate struct {
-	SetPaid bool `json:"set_paid"`
+	ParentID           *int                      `json:"parent_id,omitempty"`
+	Status             *OrderStatus              `json:"status,omitempty"`
+	Currency           *string                   `json:"currency,omitempty"`
+	CustomerID         *int                      `json:"customer_id,omitempty"`
+	CustomerNote       *string                   `json:"customer_note,omitempty"`
+	Billing            *OrderUpdateAddress       `json:"billing,omitempty"`
+	Shipping           *OrderUpdateAddress       `json:"shipping,omitempty"`
+	PaymentMethod      *string                   `json:"payment_method,omitempty"`
+	PaymentMethodTitle *string                   `json:"payment_method_title,omitempty"`
+	TransactionID      *string                   `json:"transaction_id,omitempty"`
+	MetaData           []MetaDataUpdate          `json:"meta_data,omitempty"`
+	LineItems          []OrderLineItemUpdate     `json:"line_items,omitempty"`
+	ShippingLines      []OrderShippingLineUpdate `json:"shipping_lines,omitempty"`
+	FeeLines           []OrderFeeLineUpdate      `json:"fee_lines,omitempty"`
+	CouponLines        []OrderCouponLineUpdate   `json:"coupon_lines,omitempty"`
+	// SetPaid marks the order as paid. It sets the status to processing and reduces stock of items.
+	SetPaid *bool `json:"set_paid,omitempty"`
+}
+
+// OrderUpdateAddress is a partial update of an order address.
+// Only fields that are not nil are updated.
+type OrderUpdateAddress struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Company   *string `json:"company,omitempty"`
+	Address1  *string `json:"address_1,omitempty"`
+	Address2  *string `json:"address_2,omitempty"`
+	City      *string `json:"city,omitempty"`
+	State     *string `json:"state,omitempty"`
+	Postcode  *string `json:"postcode,omitempty"`
+	Country   *string `json:"country,omitempty"`
+
+	// The following fields are only present in the billing address.
+
+	Email *string `json:"email,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+}
+
+// MetaDataUpdate updates meta data of an object. Meta data with an ID is updated,
+// meta data without an ID is updated by its key or added if the key does not exist yet.
+// Meta data with an ID and a nil value is removed.
+type MetaDataUpdate struct {
+	ID    int         `json:"id,omitempty"`
+	Key   string      `json:"key,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+// OrderLineItemUpdate adds or modifies a line item of an order. Line items without an ID are added.
+type OrderLineItemUpdate struct {
+	ID          int              `json:"id,omitempty"`
+	Name        *string          `json:"name,omitempty"`
+	ProductID   *int             `json:"product_id,omitempty"`
+	VariationID *int             `json:"variation_id,omitempty"`
+	Quantity    *int             `json:"quantity,omitempty"`
+	TaxClass    *string          `json:"tax_class,omitempty"`
+	Subtotal    *Float           `json:"subtotal,omitempty"`
+	Total       *Float           `json:"total,omitempty"`
+	MetaData    []MetaDataUpdate `json:"meta_data,omitempty"`
+
+	remove bool
+}
+
+// RemoveOrderLineItem returns an update that removes the line item with the given ID.
+func RemoveOrderLineItem(id int) OrderLineItemUpdate {
+	return OrderLineItemUpdate{ID: id, remove: true}
+}
+
+func (u OrderLineItemUpdate) MarshalJSON() ([]byte, error) {
+	if u.remove {
+		return removeLineJSON(u.ID, "product_id")
+	}
+
+	type update OrderLineItemUpdate
+	return json.Marshal(update(u))
+}
+
+// OrderShippingLineUpdate adds or modifies a shipping line of an order. Shipping lines without an ID are added.
+type OrderShippingLineUpdate struct {
+	ID          int              `json:"id,omitempty"`
+	MethodID    *string          `json:"method_id,omitempty"`
+	MethodTitle *string          `json:"method_title,omitempty"`
+	Total       *Float           `json:"total,omitempty"`
+	MetaData    []MetaDataUpdate `json:"meta_data,omitempty"`
+
+	remove bool
+}
+
+// RemoveOrderShippingLine returns an update that removes the shipping line with the given ID.
+func RemoveOrderShippingLine(id int) OrderShippingLineUpdate {
+	return OrderShippingLineUpdate{ID: id, remove: true}
+}
+
+func (u OrderShippingLineUpdate) MarshalJSON() ([]byte, error) {
+	if u.remove {
+		return removeLineJSON(u.ID, "method_id")
+	}
+
+	type update OrderShippingLineUpdate
+	return json.Marshal(update(u))
+}
+
+// OrderFeeLineUpdate adds or modifies a fee line of an order. Fee lines without an ID are added.
+type OrderFeeLineUpdate struct {
+	ID        int              `json:"id,omitempty"`
+	Name      *string          `json:"name,omitempty"`
+	TaxClass  *string          `json:"tax_class,omitempty"`
+	TaxStatus *string          `json:"tax_status,omitempty"`
+	Total     *Float           `json:"total,omitempty"`
+	MetaData  []MetaDataUpdate `json:"meta_data,omitempty"`
+
+	remove bool
+}
+
+// RemoveOrderFeeLine returns an update that removes the fee line with the given ID.
+func RemoveOrderFeeLine(id int) OrderFeeLineUpdate {
+	return OrderFeeLineUpdate{ID: id, remove: true}
+}
+
+func (u OrderFeeLineUpdate) MarshalJSON() ([]byte, error) {
+	if u.remove {
+		return removeLineJSON(u.ID, "name")
+	}
+
+	type update OrderFeeLineUpdate
+	return json.Marshal(update(u))
+}
+
+// OrderCouponLineUpdate applies or modifies a coupon of an order. Coupon lines without an ID are added.
+type OrderCouponLineUpdate struct {
+	ID       int              `json:"id,omitempty"`
+	Code     *string          `json:"code,omitempty"`
+	MetaData []MetaDataUpdate `json:"meta_data,omitempty"`
+
+	remove bool
+}
+
+// RemoveOrderCouponLine returns an update that removes the coupon line with the given ID.
+func RemoveOrderCouponLine(id int) OrderCouponLineUpdate {
+	return OrderCouponLineUpdate{ID: id, remove: true}
+}
+
+func (u OrderCouponLineUpdate) MarshalJSON() ([]byte, error) {
+	if u.remove {
+		return removeLineJSON(u.ID, "code")
+	}
+
+	type update OrderCouponLineUpdate
+	return json.Marshal(update(u))
+}
+
+// removeLineJSON returns JSON that removes the order line with the given ID.
+// Woocommerce removes lines whose identifying field is set to null.
+func removeLineJSON(id int, field string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"id":  id,
+		field: nil,
+	})
 }
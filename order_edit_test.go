@@ -0,0 +1,56 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrderUpdate_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		update   OrderUpdate
+		expected string
+	}{
+		{
+			update:   OrderUpdate{},
+			expected: `{}`,
+		},
+		{
+			update: OrderUpdate{
+				Status:  Ptr(OrderStatusCompleted),
+				Billing: &OrderUpdateAddress{Email: Ptr("john@example.com")},
+			},
+			expected: `{"status":"completed","billing":{"email":"john@example.com"}}`,
+		},
+		{
+			update: OrderUpdate{
+				LineItems: []OrderLineItemUpdate{
+					{ProductID: Ptr(5), Quantity: Ptr(2)},
+					{ID: 7, Quantity: Ptr(3)},
+					RemoveOrderLineItem(8),
+				},
+				ShippingLines: []OrderShippingLineUpdate{RemoveOrderShippingLine(9)},
+				FeeLines:      []OrderFeeLineUpdate{{Name: Ptr("Fee"), Total: Ptr(Float(1.5))}},
+				CouponLines:   []OrderCouponLineUpdate{RemoveOrderCouponLine(10)},
+			},
+			expected: `{"line_items":[{"product_id":5,"quantity":2},{"id":7,"quantity":3},{"id":8,"product_id":null}],` +
+				`"shipping_lines":[{"id":9,"method_id":null}],"fee_lines":[{"name":"Fee","total":"1.5"}],"coupon_lines":[{"code":null,"id":10}]}`,
+		},
+		{
+			update: OrderUpdate{
+				MetaData: []MetaDataUpdate{{Key: "tracking", Value: "123"}, {ID: 4}},
+				SetPaid:  Ptr(false),
+			},
+			expected: `{"meta_data":[{"key":"tracking","value":"123"},{"id":4,"value":null}],"set_paid":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		b, err := json.Marshal(c.update)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, b)
+		}
+	}
+}
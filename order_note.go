@@ -0,0 +1,32 @@
+package woocommerce
+
+// OrderNoteType is the type of order notes used for filtering notes.
+type OrderNoteType string
+
+const (
+	OrderNoteTypeAny      OrderNoteType = "any"
+	OrderNoteTypeCustomer OrderNoteType = "customer"
+	OrderNoteTypeInternal OrderNoteType = "internal"
+)
+
+// OrderNote is the order note object that the API returns.
+type OrderNote struct {
+	ID int `json:"id"`
+	// Author is the name of the user that added the note, or WooCommerce for notes added by the system.
+	Author         string `json:"author"`
+	DateCreated    Time   `json:"date_created"`
+	DateCreatedGMT Time   `json:"date_created_gmt"`
+	Note           string `json:"note"`
+	// CustomerNote is true if the note is visible to the customer.
+	CustomerNote bool `json:"customer_note"`
+}
+
+// OrderNoteCreate is used for creating an order note.
+type OrderNoteCreate struct {
+	Note string `json:"note"`
+	// CustomerNote makes the note visible to the customer. Woocommerce sends the note to the customer by email.
+	CustomerNote bool `json:"customer_note"`
+	// AddedByUser attributes the note to the user that the request is authenticated as.
+	// Otherwise, the note is attributed to the system.
+	AddedByUser bool `json:"added_by_user,omitempty"`
+}
@@ -0,0 +1,250 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items that a pager requests per page if the page size is not set.
+const DefaultPageSize = MaxPageSize
+
+// ErrNoMorePages is returned by Pager.NextPage when all pages have been returned.
+var ErrNoMorePages = errors.New("[woocommerce-go]: no more pages")
+
+// Page is a single page of a list request.
+type Page[T any] struct {
+	Items []T
+
+	// Number is the number of the page, starting with 1.
+	Number int
+	// Total is the total number of items of all pages, as reported by the X-WP-Total header.
+	// It is 0 if the header is missing.
+	Total int
+	// TotalPages is the total number of pages, as reported by the X-WP-TotalPages header.
+	// It is 0 if the header is missing.
+	TotalPages int
+	// HasNext reports whether there is a page after this one.
+	HasNext bool
+}
+
+// PageFunc fetches a single page of a list request. Parameters include the page and per_page values.
+type PageFunc[T any] func(ctx context.Context, parameters Parameters) (*Page[T], error)
+
+// PagerOption configures a pager.
+type PagerOption func(*pagerOptions)
+
+type pagerOptions struct {
+	pageSize int
+	prefetch int
+}
+
+// WithPageSize sets the number of items requested per page. It must be between 1 and MaxPageSize.
+func WithPageSize(size int) PagerOption {
+	return func(o *pagerOptions) {
+		o.pageSize = size
+	}
+}
+
+// WithPrefetch sets the number of pages that are requested in parallel ahead of the page that is being read.
+// Pages are only prefetched once the total number of pages is known from the first page.
+// By default, pages are requested one after another.
+func WithPrefetch(pages int) PagerOption {
+	return func(o *pagerOptions) {
+		o.prefetch = pages
+	}
+}
+
+// Pager walks all pages of a list request. It should be created with the Pager method of a client.
+// Pager is not safe for concurrent use.
+//
+// Items can be read one by one:
+//
+//	pager := api.Order.Pager(nil)
+//	defer pager.Close()
+//	for pager.Next(ctx) {
+//		order := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		// Handle error.
+//	}
+//
+// When the pager is not read until the end, Close should be called to cancel prefetched requests.
+type Pager[T any] struct {
+	fetch      PageFunc[T]
+	parameters Parameters
+	options    pagerOptions
+
+	// next is the number of the next page to be returned.
+	next int
+	done bool
+	err  error
+
+	// pending holds results of prefetched pages, starting with the next page.
+	pending []*pageRequest[T]
+
+	items []T
+	item  T
+}
+
+type pageRequest[T any] struct {
+	cancel context.CancelFunc
+	result chan pageResult[T]
+}
+
+type pageResult[T any] struct {
+	page *Page[T]
+	err  error
+}
+
+// NewPager creates a new pager that fetches pages with the given function.
+// Page and per_page values of the parameters are set by the pager. Parameters can be nil.
+// If the parameters or the page size are invalid, the error is returned on the first read and no request is sent.
+func NewPager[T any](parameters Parameters, fetch PageFunc[T], options ...PagerOption) *Pager[T] {
+	o := pagerOptions{
+		pageSize: DefaultPageSize,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+
+	p := &Pager[T]{
+		fetch:      fetch,
+		parameters: parameters,
+		options:    o,
+		next:       1,
+	}
+
+	// Validate parameters before any request is sent.
+	if o.pageSize < 1 || o.pageSize > MaxPageSize {
+		p.err = fmt.Errorf("%w: page size must be between 1 and %d, got %d", ErrInvalidParameters, MaxPageSize, o.pageSize)
+	} else if v, ok := parameters.(Validator); ok {
+		p.err = v.Validate()
+	}
+
+	return p
+}
+
+// NextPage returns the next page. ErrNoMorePages is returned after the last page.
+// When an error occurs, the pager stops and the same error is returned by all subsequent calls.
+func (p *Pager[T]) NextPage(ctx context.Context) (*Page[T], error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.done {
+		return nil, ErrNoMorePages
+	}
+
+	var page *Page[T]
+	var err error
+	if len(p.pending) > 0 {
+		req := p.pending[0]
+		p.pending = p.pending[1:]
+		select {
+		case r := <-req.result:
+			page, err = r.page, r.err
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		req.cancel()
+	} else {
+		page, err = p.fetch(ctx, p.pageParameters(p.next))
+	}
+
+	if err != nil {
+		p.err = err
+		p.Close()
+		return nil, err
+	}
+
+	p.next++
+	if !page.HasNext {
+		p.done = true
+		p.Close()
+		return page, nil
+	}
+
+	// Prefetch following pages if the number of pages is known.
+	for n := p.next + len(p.pending); n <= page.TotalPages && len(p.pending) < p.options.prefetch; n++ {
+		p.pending = append(p.pending, p.prefetch(ctx, n))
+	}
+
+	return page, nil
+}
+
+// prefetch requests the page with the given number in the background.
+func (p *Pager[T]) prefetch(ctx context.Context, number int) *pageRequest[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	req := &pageRequest[T]{
+		cancel: cancel,
+		result: make(chan pageResult[T], 1),
+	}
+
+	parameters := p.pageParameters(number)
+	go func() {
+		page, err := p.fetch(ctx, parameters)
+		req.result <- pageResult[T]{page: page, err: err}
+	}()
+
+	return req
+}
+
+func (p *Pager[T]) pageParameters(number int) Parameters {
+	values := url.Values{}
+	if p.parameters != nil {
+		for key, v := range p.parameters.Values() {
+			values[key] = append([]string(nil), v...)
+		}
+	}
+	values.Set("page", strconv.Itoa(number))
+	values.Set("per_page", strconv.Itoa(p.options.pageSize))
+	return BaseParameters(values)
+}
+
+// Next advances the pager to the next item, which is then available through the Item method.
+// It returns false when there are no more items or an error occurred, which is returned by the Err method.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for len(p.items) == 0 {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return false
+		}
+		p.items = page.Items
+	}
+
+	p.item = p.items[0]
+	p.items = p.items[1:]
+	return true
+}
+
+// Item returns the current item of the pager.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Collect returns all remaining items of all pages.
+func (p *Pager[T]) Collect(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+// Close stops the pager and cancels requests of prefetched pages.
+// It is safe to call Close multiple times.
+func (p *Pager[T]) Close() {
+	p.done = true
+	p.items = nil
+	for _, req := range p.pending {
+		req.cancel()
+	}
+	p.pending = nil
+}
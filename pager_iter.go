@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package woocommerce
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over all remaining items of all pages.
+// If an error occurs, it is yielded with the zero value of the item and the iteration stops.
+// Breaking out of the loop stops the pager and cancels prefetched requests.
+//
+//	for order, err := range api.Order.Pager(nil).All(ctx) {
+//		if err != nil {
+//			// Handle error.
+//		}
+//	}
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer p.Close()
+
+		for p.Next(ctx) {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// Pages returns an iterator over all remaining pages.
+// If an error occurs, it is yielded with a nil page and the iteration stops.
+// Breaking out of the loop stops the pager and cancels prefetched requests.
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[*Page[T], error] {
+	return func(yield func(*Page[T], error) bool) {
+		defer p.Close()
+
+		for {
+			page, err := p.NextPage(ctx)
+			if err == ErrNoMorePages {
+				return
+			}
+			if !yield(page, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+//go:build go1.23
+
+package woocommerce
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestPager_All(t *testing.T) {
+	var mu sync.Mutex
+	var requested []int
+	pager := NewPager(nil, fakePages(100, &requested, &mu), WithPageSize(10))
+
+	count := 0
+	for item, err := range pager.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item != count {
+			t.Fatalf("expected item %d, got %d", count, item)
+		}
+		count++
+		if count == 15 {
+			break
+		}
+	}
+
+	if len(requested) != 2 {
+		t.Errorf("expected 2 requested pages after early stop, got %v", requested)
+	}
+}
+
+func TestPager_Pages(t *testing.T) {
+	var mu sync.Mutex
+	var requested []int
+	pager := NewPager(nil, fakePages(25, &requested, &mu), WithPageSize(10), WithPrefetch(2))
+
+	var numbers []int
+	for page, err := range pager.Pages(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		numbers = append(numbers, page.Number)
+	}
+
+	if len(numbers) != 3 || numbers[0] != 1 || numbers[2] != 3 {
+		t.Errorf("unexpected pages: %v", numbers)
+	}
+}
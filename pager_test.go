@@ -0,0 +1,122 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// fakePages returns a page function that serves the given number of items.
+func fakePages(total int, requested *[]int, mu *sync.Mutex) PageFunc[int] {
+	return func(ctx context.Context, parameters Parameters) (*Page[int], error) {
+		values := parameters.Values()
+		number, _ := strconv.Atoi(values.Get("page"))
+		perPage, _ := strconv.Atoi(values.Get("per_page"))
+
+		mu.Lock()
+		*requested = append(*requested, number)
+		mu.Unlock()
+
+		page := &Page[int]{
+			Number:     number,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		}
+		for i := (number - 1) * perPage; i < number*perPage && i < total; i++ {
+			page.Items = append(page.Items, i)
+		}
+		page.HasNext = number < page.TotalPages
+		return page, nil
+	}
+}
+
+func TestPager_Collect(t *testing.T) {
+	for _, prefetch := range []int{0, 3} {
+		var mu sync.Mutex
+		var requested []int
+		pager := NewPager(BaseParameters{"status": {"completed"}}, fakePages(25, &requested, &mu), WithPageSize(10), WithPrefetch(prefetch))
+
+		items, err := pager.Collect(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 25 {
+			t.Fatalf("prefetch %d: expected 25 items, got %d", prefetch, len(items))
+		}
+		for i, item := range items {
+			if item != i {
+				t.Fatalf("prefetch %d: expected item %d at index %d, got %d", prefetch, i, i, item)
+			}
+		}
+		if len(requested) != 3 {
+			t.Errorf("prefetch %d: expected 3 requests, got %v", prefetch, requested)
+		}
+
+		if _, err := pager.NextPage(context.Background()); err != ErrNoMorePages {
+			t.Errorf("prefetch %d: expected ErrNoMorePages, got %v", prefetch, err)
+		}
+	}
+}
+
+func TestPager_Parameters(t *testing.T) {
+	parameters := BaseParameters{"status": {"completed"}}
+	pager := NewPager(parameters, func(ctx context.Context, p Parameters) (*Page[int], error) {
+		values := p.Values()
+		if values.Get("status") != "completed" || values.Get("page") != "1" || values.Get("per_page") != "100" {
+			t.Errorf("unexpected parameters: %v", values)
+		}
+		return &Page[int]{Number: 1}, nil
+	})
+
+	if _, err := pager.Collect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if parameters.Values().Get("page") != "" {
+		t.Error("pager modified the given parameters")
+	}
+}
+
+func TestPager_Error(t *testing.T) {
+	errPage := errors.New("page error")
+	calls := 0
+	pager := NewPager(nil, func(ctx context.Context, parameters Parameters) (*Page[int], error) {
+		calls++
+		if calls == 2 {
+			return nil, errPage
+		}
+		return &Page[int]{Items: []int{1, 2}, Number: calls, HasNext: true}, nil
+	})
+
+	items, err := pager.Collect(context.Background())
+	if err != errPage {
+		t.Fatalf("expected page error, got %v", err)
+	}
+	if len(items) != 2 {
+		t.Errorf("expected 2 items before the error, got %d", len(items))
+	}
+	if _, err := pager.NextPage(context.Background()); err != errPage {
+		t.Errorf("expected the same error after failure, got %v", err)
+	}
+}
+
+func TestPager_Close(t *testing.T) {
+	var mu sync.Mutex
+	var requested []int
+	pager := NewPager(nil, fakePages(1000, &requested, &mu), WithPageSize(10), WithPrefetch(2))
+
+	if !pager.Next(context.Background()) {
+		t.Fatal(pager.Err())
+	}
+	pager.Close()
+
+	if pager.Next(context.Background()) {
+		t.Error("expected no more items after close")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requested) > 3 {
+		t.Errorf("expected at most 3 requested pages, got %v", requested)
+	}
+}
@@ -1,15 +1,30 @@
 package woocommerce
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// MaxPageSize is the maximum number of items per page that woocommerce allows.
+const MaxPageSize = 100
+
+// ErrInvalidParameters is returned when parameters are rejected before the request is sent.
+// Errors returned by the Validate methods of parameters wrap it.
+var ErrInvalidParameters = errors.New("[woocommerce-go]: invalid parameters")
+
 // Parameters specifies a type that can return GET parameters of the request.
 type Parameters interface {
 	Values() url.Values
 }
 
+// Validator is implemented by parameters that can be validated. Parameters implementing
+// it are validated before the request is sent and the request is not sent if they are invalid.
+type Validator interface {
+	Validate() error
+}
+
 // BaseParameters is a wrapper around url.Values type.
 type BaseParameters url.Values
 
@@ -24,15 +39,46 @@ func ParametersWithValue(p Parameters, key, value string) Parameters {
 	return BaseParameters(v)
 }
 
+// SortOrder is the order in which list queries are sorted.
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Validate checks that the sort order is either empty, asc or desc.
+func (o SortOrder) Validate() error {
+	if o != "" && o != SortOrderAsc && o != SortOrderDesc {
+		return fmt.Errorf("%w: order must be asc or desc, got %q", ErrInvalidParameters, o)
+	}
+	return nil
+}
+
 // PageParams represents parameters that are used to specify pagination values
-// of list queries.
+// of list queries. Zero values are omitted, so woocommerce defaults are used.
 type PageParams struct {
 	Page, PerPage int
 }
 
 func (p PageParams) Values() url.Values {
 	values := url.Values{}
-	values.Set("page", strconv.Itoa(p.Page))
-	values.Set("per_page", strconv.Itoa(p.PerPage))
+	if p.Page != 0 {
+		values.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage != 0 {
+		values.Set("per_page", strconv.Itoa(p.PerPage))
+	}
 	return values
 }
+
+// Validate checks that the page is not negative and that per page is at most MaxPageSize.
+func (p PageParams) Validate() error {
+	if p.Page < 0 {
+		return fmt.Errorf("%w: page must not be negative, got %d", ErrInvalidParameters, p.Page)
+	}
+	if p.PerPage < 0 || p.PerPage > MaxPageSize {
+		return fmt.Errorf("%w: per_page must be between 1 and %d, got %d", ErrInvalidParameters, MaxPageSize, p.PerPage)
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package woocommerce
 
+import "encoding/json"
+
 type ProductType string
 
 const (
@@ -16,34 +18,237 @@ const (
 	ProductStatusPending ProductStatus = "pending"
 	ProductStatusPrivate ProductStatus = "private"
 	ProductStatusPublish ProductStatus = "publish"
+	ProductStatusFuture  ProductStatus = "future"
+	ProductStatusTrash   ProductStatus = "trash"
+
+	// ProductStatusAny is only used when listing products, to list products with any status except trash.
+	ProductStatusAny ProductStatus = "any"
+)
+
+// CatalogVisibility determines where the product is shown in the store.
+type CatalogVisibility string
+
+const (
+	CatalogVisibilityVisible CatalogVisibility = "visible"
+	CatalogVisibilityCatalog CatalogVisibility = "catalog"
+	CatalogVisibilitySearch  CatalogVisibility = "search"
+	CatalogVisibilityHidden  CatalogVisibility = "hidden"
+)
+
+// TaxStatus determines which parts of the product price are taxed.
+type TaxStatus string
+
+const (
+	TaxStatusTaxable  TaxStatus = "taxable"
+	TaxStatusShipping TaxStatus = "shipping"
+	TaxStatusNone     TaxStatus = "none"
+)
+
+type StockStatus string
+
+const (
+	StockStatusInStock     StockStatus = "instock"
+	StockStatusOutOfStock  StockStatus = "outofstock"
+	StockStatusOnBackorder StockStatus = "onbackorder"
+)
+
+// Backorders determines whether the product can be ordered when it is out of stock.
+type Backorders string
+
+const (
+	BackordersNo     Backorders = "no"
+	BackordersNotify Backorders = "notify"
+	BackordersYes    Backorders = "yes"
 )
 
 // ProductCommon contains the common fields of product and product variation.
+//
+// Fields with zero values are omitted when the product is created. Prices that are not valid
+// are sent as null, which woocommerce ignores. Products are updated with ProductUpdate
+// and variations with ProductVariationUpdate, which can also unset fields.
 type ProductCommon struct {
-	ID           int           `json:"id,omitempty"`
-	DateCreated  string        `json:"date_created,omitempty"`
-	DateModified string        `json:"date_modified,omitempty"`
-	Status       ProductStatus `json:"status,omitempty"`
-	Description  string        `json:"description,omitempty"`
-	SKU          string        `json:"sku,omitempty"`
-	Price        NullFloat     `json:"price,omitempty"`
-	RegularPrice NullFloat     `json:"regular_price,omitempty"`
-	SalePrice    NullFloat     `json:"sale_price,omitempty"`
-	MetaData     []MetaData    `json:"meta_data,omitempty"`
+	ID              int           `json:"id,omitempty"`
+	DateCreated     string        `json:"date_created,omitempty"`
+	DateCreatedGMT  string        `json:"date_created_gmt,omitempty"`
+	DateModified    string        `json:"date_modified,omitempty"`
+	DateModifiedGMT string        `json:"date_modified_gmt,omitempty"`
+	Permalink       string        `json:"permalink,omitempty"`
+	Status          ProductStatus `json:"status,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	SKU             string        `json:"sku,omitempty"`
+	Price           NullFloat     `json:"price,omitempty"`
+	RegularPrice    NullFloat     `json:"regular_price,omitempty"`
+	SalePrice       NullFloat     `json:"sale_price,omitempty"`
+	// DateOnSaleFrom and DateOnSaleTo limit the sale price to a period, in the timezone of the store.
+	// They are nil if the sale is not scheduled.
+	DateOnSaleFrom    *Time `json:"date_on_sale_from,omitempty"`
+	DateOnSaleFromGMT *Time `json:"date_on_sale_from_gmt,omitempty"`
+	DateOnSaleTo      *Time `json:"date_on_sale_to,omitempty"`
+	DateOnSaleToGMT   *Time `json:"date_on_sale_to_gmt,omitempty"`
+	OnSale            bool  `json:"on_sale,omitempty"`
+	Purchasable       bool  `json:"purchasable,omitempty"`
+	Virtual           bool  `json:"virtual,omitempty"`
+	Downloadable      bool  `json:"downloadable,omitempty"`
+	// Downloads are files of a downloadable product.
+	Downloads []ProductDownload `json:"downloads,omitempty"`
+	// DownloadLimit is the number of times a file can be downloaded. It is -1 if downloads are not limited.
+	DownloadLimit int `json:"download_limit,omitempty"`
+	// DownloadExpiry is the number of days until access to files expires. It is -1 if access never expires.
+	DownloadExpiry int       `json:"download_expiry,omitempty"`
+	TaxStatus      TaxStatus `json:"tax_status,omitempty"`
+	TaxClass       string    `json:"tax_class,omitempty"`
+	// StockQuantity is the number of items in stock. It is nil if stock is not managed.
+	StockQuantity     *int        `json:"stock_quantity,omitempty"`
+	StockStatus       StockStatus `json:"stock_status,omitempty"`
+	Backorders        Backorders  `json:"backorders,omitempty"`
+	BackordersAllowed bool        `json:"backorders_allowed,omitempty"`
+	Backordered       bool        `json:"backordered,omitempty"`
+	// LowStockAmount is the stock quantity at which the store is notified. It is nil if the store setting is used.
+	LowStockAmount *int `json:"low_stock_amount,omitempty"`
+	// Weight is the weight of the product in the weight unit of the store.
+	Weight          string             `json:"weight,omitempty"`
+	Dimensions      *ProductDimensions `json:"dimensions,omitempty"`
+	ShippingClass   string             `json:"shipping_class,omitempty"`
+	ShippingClassID int                `json:"shipping_class_id,omitempty"`
+	MenuOrder       int                `json:"menu_order,omitempty"`
+	MetaData        []MetaData         `json:"meta_data,omitempty"`
 }
 
 type Product struct {
 	ProductCommon
 
-	Name             string      `json:"name,omitempty"`
-	Slug             string      `json:"slug,omitempty"`
-	Type             ProductType `json:"type,omitempty"`
-	Featured         bool        `json:"featured,omitempty"`
-	ShortDescription string      `json:"short_description,omitempty"`
-	ParentID         int         `json:"parent_id,omitempty"`
-	Variations       []int       `json:"variations,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Slug              string            `json:"slug,omitempty"`
+	Type              ProductType       `json:"type,omitempty"`
+	Featured          bool              `json:"featured,omitempty"`
+	CatalogVisibility CatalogVisibility `json:"catalog_visibility,omitempty"`
+	ShortDescription  string            `json:"short_description,omitempty"`
+	PriceHTML         string            `json:"price_html,omitempty"`
+	TotalSales        int               `json:"total_sales,omitempty"`
+	// ExternalURL is the URL of an external product.
+	ExternalURL string `json:"external_url,omitempty"`
+	// ButtonText is the text of the buy button of an external product.
+	ButtonText string `json:"button_text,omitempty"`
+	// ManageStock enables stock management of the product.
+	ManageStock      bool `json:"manage_stock,omitempty"`
+	SoldIndividually bool `json:"sold_individually,omitempty"`
+	ShippingRequired bool `json:"shipping_required,omitempty"`
+	ShippingTaxable  bool `json:"shipping_taxable,omitempty"`
+	ReviewsAllowed   bool `json:"reviews_allowed,omitempty"`
+	// AverageRating is the average rating of reviews, formatted as a decimal number.
+	AverageRating     string                      `json:"average_rating,omitempty"`
+	RatingCount       int                         `json:"rating_count,omitempty"`
+	RelatedIDs        []int                       `json:"related_ids,omitempty"`
+	UpsellIDs         []int                       `json:"upsell_ids,omitempty"`
+	CrossSellIDs      []int                       `json:"cross_sell_ids,omitempty"`
+	ParentID          int                         `json:"parent_id,omitempty"`
+	PurchaseNote      string                      `json:"purchase_note,omitempty"`
+	Categories        []ProductTermRef            `json:"categories,omitempty"`
+	Tags              []ProductTermRef            `json:"tags,omitempty"`
+	Images            []ProductImage              `json:"images,omitempty"`
+	Attributes        []ProductAttribute          `json:"attributes,omitempty"`
+	DefaultAttributes []ProductVariationAttribute `json:"default_attributes,omitempty"`
+	Variations        []int                       `json:"variations,omitempty"`
+	// GroupedProducts holds IDs of products in a grouped product.
+	GroupedProducts []int `json:"grouped_products,omitempty"`
 }
 
 type ProductVariation struct {
 	ProductCommon
+
+	ManageStock ManageStock   `json:"manage_stock,omitempty"`
+	Image       *ProductImage `json:"image,omitempty"`
+	// Attributes hold the option of each variation attribute of the parent product.
+	// Attributes without an option match any option.
+	Attributes []ProductVariationAttribute `json:"attributes,omitempty"`
+}
+
+// ManageStock is the stock management setting of a product variation.
+// Stock of a variation can be managed by the variation itself or by its parent product.
+type ManageStock string
+
+const (
+	ManageStockEnabled  ManageStock = "true"
+	ManageStockDisabled ManageStock = "false"
+	// ManageStockParent means that stock is managed by the parent product.
+	// It is only returned by woocommerce and disables stock management of the variation when it is sent.
+	ManageStockParent ManageStock = "parent"
+)
+
+func (m ManageStock) MarshalJSON() ([]byte, error) {
+	if m == ManageStockParent {
+		return []byte("false"), nil
+	}
+	return json.Marshal(m == ManageStockEnabled)
+}
+
+func (m *ManageStock) UnmarshalJSON(bytes []byte) error {
+	switch string(bytes) {
+	case "true":
+		*m = ManageStockEnabled
+	case "false", "null":
+		*m = ManageStockDisabled
+	default:
+		var val string
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			return err
+		}
+		*m = ManageStock(val)
+	}
+	return nil
+}
+
+type ProductDimensions struct {
+	Length string `json:"length,omitempty"`
+	Width  string `json:"width,omitempty"`
+	Height string `json:"height,omitempty"`
+}
+
+// ProductDownload is a downloadable file of a product.
+type ProductDownload struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	File string `json:"file,omitempty"`
+}
+
+// ProductTermRef references a category or a tag of a product. Only the ID has to be set
+// when the product is created or updated.
+type ProductTermRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// ProductImage is an image of a product. Existing images are referenced by their ID,
+// new images are uploaded from Src.
+type ProductImage struct {
+	ID              int    `json:"id,omitempty"`
+	DateCreated     string `json:"date_created,omitempty"`
+	DateCreatedGMT  string `json:"date_created_gmt,omitempty"`
+	DateModified    string `json:"date_modified,omitempty"`
+	DateModifiedGMT string `json:"date_modified_gmt,omitempty"`
+	Src             string `json:"src,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Alt             string `json:"alt,omitempty"`
+}
+
+// ProductAttribute is an attribute of a product. Global attributes are referenced by their ID,
+// while attributes with ID 0 are custom attributes of the product.
+type ProductAttribute struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Position int    `json:"position"`
+	// Visible shows the attribute on the product page.
+	Visible bool `json:"visible"`
+	// Variation means that the attribute is used for variations.
+	Variation bool     `json:"variation"`
+	Options   []string `json:"options,omitempty"`
+}
+
+// ProductVariationAttribute is the option of an attribute of a product variation
+// or a default attribute of a variable product.
+type ProductVariationAttribute struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Option string `json:"option"`
 }
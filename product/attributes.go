@@ -0,0 +1,393 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathAttributes     = "/products/attributes"
+	pathAttributeEdit  = "/products/attributes/%d"
+	pathAttributeBatch = "/products/attributes/batch"
+	pathTerms          = "/products/attributes/%d/terms"
+	pathTermEdit       = "/products/attributes/%d/terms/%d"
+	pathTermBatch      = "/products/attributes/%d/terms/batch"
+)
+
+// AttributeClient is the API client used for working with global product attributes and their terms.
+// It should not be initialized directly. Use Client.Attributes instead.
+//
+// AttributeID and TermID resolve names and slugs to IDs. Attributes and terms are loaded once and cached
+// on the client. The cache is cleared when attributes or terms are changed through the client
+// and reloaded when a name is not found, so attributes created elsewhere are found as well.
+type AttributeClient struct {
+	backend woocommerce.Backend
+	cache   *attributeCache
+}
+
+// attributeCache holds IDs of attributes and terms for lookups.
+type attributeCache struct {
+	mu sync.Mutex
+	// attributes maps lowercase names and slugs of attributes to their IDs. It is nil until attributes are loaded.
+	attributes map[string]int
+	// terms maps attribute IDs to maps of lowercase term slugs to term IDs.
+	terms map[int]map[string]int
+}
+
+func newAttributeClient(backend woocommerce.Backend) *AttributeClient {
+	return &AttributeClient{
+		backend: backend,
+		cache:   &attributeCache{terms: map[int]map[string]int{}},
+	}
+}
+
+// List returns all global attributes. Woocommerce does not paginate attributes.
+func (c AttributeClient) List() ([]*woocommerce.Attribute, error) {
+	return c.ListContext(context.Background())
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c AttributeClient) ListContext(ctx context.Context) ([]*woocommerce.Attribute, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathAttributes, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var attributes []*woocommerce.Attribute
+	err = json.NewDecoder(resp.Body).Decode(&attributes)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal attributes json: %w", err)
+	}
+
+	return attributes, nil
+}
+
+// Retrieve retrieves a single attribute by its ID.
+func (c AttributeClient) Retrieve(attributeID int) (*woocommerce.Attribute, error) {
+	return c.RetrieveContext(context.Background(), attributeID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c AttributeClient) RetrieveContext(ctx context.Context, attributeID int) (*woocommerce.Attribute, error) {
+	path := fmt.Sprintf(pathAttributeEdit, attributeID)
+	return c.attributeRequest(ctx, http.MethodGet, path, nil, nil)
+}
+
+// Create creates a new attribute. Woocommerce prefixes the slug with pa_.
+func (c AttributeClient) Create(attribute *woocommerce.Attribute) (*woocommerce.Attribute, error) {
+	return c.CreateContext(context.Background(), attribute)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c AttributeClient) CreateContext(ctx context.Context, attribute *woocommerce.Attribute) (*woocommerce.Attribute, error) {
+	defer c.cache.clearAttributes()
+	return c.attributeRequest(ctx, http.MethodPost, pathAttributes, attribute, nil)
+}
+
+// Update updates the attribute with a given ID.
+func (c AttributeClient) Update(attributeID int, attribute *woocommerce.Attribute) (*woocommerce.Attribute, error) {
+	return c.UpdateContext(context.Background(), attributeID, attribute)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c AttributeClient) UpdateContext(ctx context.Context, attributeID int, attribute *woocommerce.Attribute) (*woocommerce.Attribute, error) {
+	defer c.cache.clearAttributes()
+	path := fmt.Sprintf(pathAttributeEdit, attributeID)
+	return c.attributeRequest(ctx, http.MethodPut, path, attribute, nil)
+}
+
+// Delete permanently deletes the attribute with a given ID, together with its terms, and returns the deleted attribute.
+// Woocommerce does not support moving attributes to trash.
+func (c AttributeClient) Delete(attributeID int) (*woocommerce.Attribute, error) {
+	return c.DeleteContext(context.Background(), attributeID)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c AttributeClient) DeleteContext(ctx context.Context, attributeID int) (*woocommerce.Attribute, error) {
+	defer c.cache.clearAttributes()
+	defer c.cache.clearTerms(attributeID)
+	path := fmt.Sprintf(pathAttributeEdit, attributeID)
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	return c.attributeRequest(ctx, http.MethodDelete, path, nil, parameters)
+}
+
+func (c AttributeClient) attributeRequest(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*woocommerce.Attribute, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var attribute woocommerce.Attribute
+	err = json.NewDecoder(resp.Body).Decode(&attribute)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal attribute json: %w", err)
+	}
+
+	return &attribute, nil
+}
+
+// Batch creates, updates and deletes multiple attributes. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Attributes that could not be processed are reported by the Err method of the response.
+func (c AttributeClient) Batch(request woocommerce.BatchRequest[woocommerce.Attribute, woocommerce.Attribute]) (*woocommerce.BatchResponse[*woocommerce.Attribute], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c AttributeClient) BatchContext(ctx context.Context, request woocommerce.BatchRequest[woocommerce.Attribute, woocommerce.Attribute]) (*woocommerce.BatchResponse[*woocommerce.Attribute], error) {
+	defer c.cache.clearAttributes()
+	for _, id := range request.Delete {
+		c.cache.clearTerms(id)
+	}
+	return backend.Batch[woocommerce.Attribute, woocommerce.Attribute, *woocommerce.Attribute](ctx, c.backend, pathAttributeBatch, request)
+}
+
+// ListTerms returns a list of terms of the attribute with given parameters and total term count.
+func (c AttributeClient) ListTerms(attributeID int, parameters woocommerce.Parameters) ([]*woocommerce.AttributeTerm, int, error) {
+	return c.ListTermsContext(context.Background(), attributeID, parameters)
+}
+
+// ListTermsContext is the same as ListTerms, but it uses the given context for the request.
+func (c AttributeClient) ListTermsContext(ctx context.Context, attributeID int, parameters woocommerce.Parameters) ([]*woocommerce.AttributeTerm, int, error) {
+	page, err := backend.ListPage[*woocommerce.AttributeTerm](ctx, c.backend, fmt.Sprintf(pathTerms, attributeID), parameters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return page.Items, page.Total, nil
+}
+
+// TermsPager returns a pager that walks all pages of terms of the attribute with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c AttributeClient) TermsPager(attributeID int, parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.AttributeTerm] {
+	path := fmt.Sprintf(pathTerms, attributeID)
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.AttributeTerm], error) {
+		return backend.ListPage[*woocommerce.AttributeTerm](ctx, c.backend, path, parameters)
+	}, options...)
+}
+
+// RetrieveTerm retrieves a single term of the attribute.
+func (c AttributeClient) RetrieveTerm(attributeID, termID int) (*woocommerce.AttributeTerm, error) {
+	return c.RetrieveTermContext(context.Background(), attributeID, termID)
+}
+
+// RetrieveTermContext is the same as RetrieveTerm, but it uses the given context for the request.
+func (c AttributeClient) RetrieveTermContext(ctx context.Context, attributeID, termID int) (*woocommerce.AttributeTerm, error) {
+	path := fmt.Sprintf(pathTermEdit, attributeID, termID)
+	return c.termRequest(ctx, http.MethodGet, path, nil, nil)
+}
+
+// CreateTerm creates a new term of the attribute.
+func (c AttributeClient) CreateTerm(attributeID int, term *woocommerce.AttributeTerm) (*woocommerce.AttributeTerm, error) {
+	return c.CreateTermContext(context.Background(), attributeID, term)
+}
+
+// CreateTermContext is the same as CreateTerm, but it uses the given context for the request.
+func (c AttributeClient) CreateTermContext(ctx context.Context, attributeID int, term *woocommerce.AttributeTerm) (*woocommerce.AttributeTerm, error) {
+	defer c.cache.clearTerms(attributeID)
+	path := fmt.Sprintf(pathTerms, attributeID)
+	return c.termRequest(ctx, http.MethodPost, path, term, nil)
+}
+
+// UpdateTerm updates a term of the attribute.
+func (c AttributeClient) UpdateTerm(attributeID, termID int, term *woocommerce.AttributeTerm) (*woocommerce.AttributeTerm, error) {
+	return c.UpdateTermContext(context.Background(), attributeID, termID, term)
+}
+
+// UpdateTermContext is the same as UpdateTerm, but it uses the given context for the request.
+func (c AttributeClient) UpdateTermContext(ctx context.Context, attributeID, termID int, term *woocommerce.AttributeTerm) (*woocommerce.AttributeTerm, error) {
+	defer c.cache.clearTerms(attributeID)
+	path := fmt.Sprintf(pathTermEdit, attributeID, termID)
+	return c.termRequest(ctx, http.MethodPut, path, term, nil)
+}
+
+// DeleteTerm permanently deletes a term of the attribute and returns the deleted term.
+// Woocommerce does not support moving terms to trash.
+func (c AttributeClient) DeleteTerm(attributeID, termID int) (*woocommerce.AttributeTerm, error) {
+	return c.DeleteTermContext(context.Background(), attributeID, termID)
+}
+
+// DeleteTermContext is the same as DeleteTerm, but it uses the given context for the request.
+func (c AttributeClient) DeleteTermContext(ctx context.Context, attributeID, termID int) (*woocommerce.AttributeTerm, error) {
+	defer c.cache.clearTerms(attributeID)
+	path := fmt.Sprintf(pathTermEdit, attributeID, termID)
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	return c.termRequest(ctx, http.MethodDelete, path, nil, parameters)
+}
+
+func (c AttributeClient) termRequest(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*woocommerce.AttributeTerm, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var term woocommerce.AttributeTerm
+	err = json.NewDecoder(resp.Body).Decode(&term)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal attribute term json: %w", err)
+	}
+
+	return &term, nil
+}
+
+// BatchTerms creates, updates and deletes multiple terms of the attribute. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Terms that could not be processed are reported by the Err method of the response.
+func (c AttributeClient) BatchTerms(attributeID int, request woocommerce.BatchRequest[woocommerce.AttributeTerm, woocommerce.AttributeTerm]) (*woocommerce.BatchResponse[*woocommerce.AttributeTerm], error) {
+	return c.BatchTermsContext(context.Background(), attributeID, request)
+}
+
+// BatchTermsContext is the same as BatchTerms, but it uses the given context for the request.
+func (c AttributeClient) BatchTermsContext(ctx context.Context, attributeID int, request woocommerce.BatchRequest[woocommerce.AttributeTerm, woocommerce.AttributeTerm]) (*woocommerce.BatchResponse[*woocommerce.AttributeTerm], error) {
+	defer c.cache.clearTerms(attributeID)
+	path := fmt.Sprintf(pathTermBatch, attributeID)
+	return backend.Batch[woocommerce.AttributeTerm, woocommerce.AttributeTerm, *woocommerce.AttributeTerm](ctx, c.backend, path, request)
+}
+
+// ReorderTerms sets the menu order of terms of the attribute to the order of the given IDs, starting with 0.
+// The order is used by the store if the attribute is sorted by woocommerce.AttributeOrderByMenuOrder.
+func (c AttributeClient) ReorderTerms(attributeID int, termIDs []int) (*woocommerce.BatchResponse[*woocommerce.AttributeTerm], error) {
+	return c.ReorderTermsContext(context.Background(), attributeID, termIDs)
+}
+
+// ReorderTermsContext is the same as ReorderTerms, but it uses the given context for the request.
+func (c AttributeClient) ReorderTermsContext(ctx context.Context, attributeID int, termIDs []int) (*woocommerce.BatchResponse[*woocommerce.AttributeTerm], error) {
+	// Menu order is set explicitly, because AttributeTerm omits the menu order of the first term.
+	type termOrder struct {
+		MenuOrder int `json:"menu_order"`
+	}
+
+	var request woocommerce.BatchRequest[woocommerce.AttributeTerm, termOrder]
+	for i, id := range termIDs {
+		request.Update = append(request.Update, woocommerce.BatchUpdate[termOrder]{ID: id, Update: termOrder{MenuOrder: i}})
+	}
+
+	path := fmt.Sprintf(pathTermBatch, attributeID)
+	return backend.Batch[woocommerce.AttributeTerm, termOrder, *woocommerce.AttributeTerm](ctx, c.backend, path, request)
+}
+
+// AttributeID returns the ID of the attribute with the given name or slug. The slug can be given with or without the pa_ prefix.
+// If there is no such attribute, woocommerce.ErrNotFound is returned.
+func (c AttributeClient) AttributeID(name string) (int, error) {
+	return c.AttributeIDContext(context.Background(), name)
+}
+
+// AttributeIDContext is the same as AttributeID, but it uses the given context for the request.
+func (c AttributeClient) AttributeIDContext(ctx context.Context, name string) (int, error) {
+	key := strings.ToLower(name)
+	if id, ok := c.cache.attribute(key); ok {
+		return id, nil
+	}
+
+	// Attributes are reloaded if the name is not found, since the attribute may have been created elsewhere.
+	attributes, err := c.ListContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	ids := map[string]int{}
+	for _, attribute := range attributes {
+		ids[strings.ToLower(attribute.Name)] = attribute.ID
+		ids[strings.ToLower(attribute.Slug)] = attribute.ID
+		ids[strings.TrimPrefix(strings.ToLower(attribute.Slug), "pa_")] = attribute.ID
+	}
+	c.cache.setAttributes(ids)
+
+	if id, ok := ids[key]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("%w: attribute with name %q", woocommerce.ErrNotFound, name)
+}
+
+// TermID returns the ID of the term with the given slug of the attribute with a given ID.
+// If there is no such term, woocommerce.ErrNotFound is returned.
+func (c AttributeClient) TermID(attributeID int, slug string) (int, error) {
+	return c.TermIDContext(context.Background(), attributeID, slug)
+}
+
+// TermIDContext is the same as TermID, but it uses the given context for the request.
+func (c AttributeClient) TermIDContext(ctx context.Context, attributeID int, slug string) (int, error) {
+	key := strings.ToLower(slug)
+	if id, ok := c.cache.term(attributeID, key); ok {
+		return id, nil
+	}
+
+	// Terms are reloaded if the slug is not found, since the term may have been created elsewhere.
+	terms, err := c.TermsPager(attributeID, nil).Collect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	ids := make(map[string]int, len(terms))
+	for _, term := range terms {
+		ids[strings.ToLower(term.Slug)] = term.ID
+	}
+	c.cache.setTerms(attributeID, ids)
+
+	if id, ok := ids[key]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("%w: term with slug %q of attribute %d", woocommerce.ErrNotFound, slug, attributeID)
+}
+
+// ClearCache clears cached IDs of attributes and terms.
+func (c AttributeClient) ClearCache() {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.attributes = nil
+	c.cache.terms = map[int]map[string]int{}
+}
+
+func (c *attributeCache) attribute(key string) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id, ok := c.attributes[key]
+	return id, ok
+}
+
+func (c *attributeCache) setAttributes(ids map[string]int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.attributes = ids
+}
+
+func (c *attributeCache) clearAttributes() {
+	c.setAttributes(nil)
+}
+
+func (c *attributeCache) term(attributeID int, key string) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id, ok := c.terms[attributeID][key]
+	return id, ok
+}
+
+func (c *attributeCache) setTerms(attributeID int, ids map[string]int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.terms[attributeID] = ids
+}
+
+func (c *attributeCache) clearTerms(attributeID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.terms, attributeID)
+}
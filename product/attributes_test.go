@@ -0,0 +1,134 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestAttributeClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret)).Attributes
+
+	color, err := client.Create(&woocommerce.Attribute{Name: "Color", OrderBy: woocommerce.AttributeOrderByMenuOrder})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if color.Slug != "pa_color" || color.Type != "select" {
+		t.Errorf("unexpected created attribute: %+v", color)
+	}
+
+	// Archives can be enabled and disabled again.
+	for _, enabled := range []bool{true, false} {
+		updated, err := client.Update(color.ID, &woocommerce.Attribute{HasArchives: woocommerce.Ptr(enabled)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated.HasArchives == nil || *updated.HasArchives != enabled || updated.Name != "Color" {
+			t.Errorf("expected archives to be %v: %+v", enabled, updated)
+		}
+	}
+
+	response, err := client.BatchTerms(color.ID, woocommerce.BatchRequest[woocommerce.AttributeTerm, woocommerce.AttributeTerm]{
+		Create: []woocommerce.AttributeTerm{{Name: "Red"}, {Name: "Light Blue"}, {Name: "Green"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Err(); err != nil {
+		t.Fatal(err)
+	}
+	red, blue, green := response.Create[0].ID, response.Create[1].ID, response.Create[2].ID
+
+	if _, err := client.ReorderTerms(color.ID, []int{green, red, blue}); err != nil {
+		t.Fatal(err)
+	}
+	terms, err := client.TermsPager(color.ID, woocommerce.BaseParameters{"orderby": {"menu_order"}}).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terms) != 3 || terms[0].ID != green || terms[1].ID != red || terms[2].MenuOrder != 2 {
+		t.Errorf("unexpected order of terms: %+v", terms)
+	}
+
+	updated, err := client.UpdateTerm(color.ID, blue, &woocommerce.AttributeTerm{Description: "Sky"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Light Blue" || updated.Description != "Sky" {
+		t.Errorf("unexpected updated term: %+v", updated)
+	}
+
+	if _, err := client.DeleteTerm(color.ID, red); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, err := client.ListTerms(color.ID, nil); err != nil || total != 2 {
+		t.Errorf("expected 2 terms, got %d (%v)", total, err)
+	}
+
+	if _, err := client.Delete(color.ID); err != nil {
+		t.Fatal(err)
+	}
+	attributes, err := client.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attributes) != 0 {
+		t.Errorf("expected no attributes, got %+v", attributes)
+	}
+}
+
+func TestAttributeClient_Lookup(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	b := backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret)
+	requests := 0
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](woocommerce.BackendFunc(func(ctx context.Context, apiType woocommerce.APIType, method, path string, body interface{}, parameters woocommerce.Parameters, headers map[string]string) (*http.Response, error) {
+		requests++
+		return b.AuthenticatedRequestContext(ctx, apiType, method, path, body, parameters, headers)
+	})).Attributes
+
+	size, err := client.Create(&woocommerce.Attribute{Name: "Size"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateTerm(size.ID, &woocommerce.AttributeTerm{Name: "XL"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"Size", "size", "pa_size"} {
+		id, err := client.AttributeID(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != size.ID {
+			t.Errorf("%s: expected attribute %d, got %d", name, size.ID, id)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.TermID(size.ID, "XL"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Two creates, one attribute list and one term list.
+	if requests != 4 {
+		t.Errorf("expected lookups to be cached, got %d requests", requests)
+	}
+
+	// Missing names reload the cache.
+	if _, err := client.AttributeID("color"); !errors.Is(err, woocommerce.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.TermID(size.ID, "xxl"); !errors.Is(err, woocommerce.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if requests != 6 {
+		t.Errorf("expected missing names to reload the cache, got %d requests", requests)
+	}
+}
@@ -0,0 +1,240 @@
+package product
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// CategoryNode is a category in a CategoryTree.
+type CategoryNode struct {
+	*woocommerce.ProductCategory
+
+	// Parent is the parent node. It is nil for top level and orphaned categories.
+	Parent *CategoryNode
+	// Children are sorted by menu order and then by name, as in the store.
+	Children []*CategoryNode
+}
+
+// Path returns names of categories from the top level category to this one.
+func (n *CategoryNode) Path() []string {
+	var path []string
+	for node := n; node != nil; node = node.Parent {
+		path = append([]string{node.Name}, path...)
+	}
+	return path
+}
+
+// SlugPath returns slugs of categories from the top level category to this one.
+func (n *CategoryNode) SlugPath() []string {
+	var path []string
+	for node := n; node != nil; node = node.Parent {
+		path = append([]string{node.Slug}, path...)
+	}
+	return path
+}
+
+// Depth returns the number of ancestors of the category. Top level categories have depth 0.
+func (n *CategoryNode) Depth() int {
+	depth := 0
+	for node := n.Parent; node != nil; node = node.Parent {
+		depth++
+	}
+	return depth
+}
+
+// CategoryTree is a hierarchy of product categories.
+// It can be loaded with CategoryClient.Tree or built from categories of another system with NewCategoryTree.
+type CategoryTree struct {
+	// Roots are top level categories, sorted by menu order and then by name.
+	Roots []*CategoryNode
+	// Orphans are categories whose parent does not exist or whose ancestors form a cycle.
+	// They are not reachable from Roots, but their children are attached to them.
+	Orphans []*CategoryNode
+
+	nodes map[int]*CategoryNode
+}
+
+// NewCategoryTree builds a tree of the given categories, linked by their IDs and parents.
+func NewCategoryTree(categories []*woocommerce.ProductCategory) *CategoryTree {
+	t := &CategoryTree{nodes: make(map[int]*CategoryNode, len(categories))}
+	for _, category := range categories {
+		t.nodes[category.ID] = &CategoryNode{ProductCategory: category}
+	}
+
+	for _, category := range categories {
+		node := t.nodes[category.ID]
+		if category.Parent == 0 {
+			t.Roots = append(t.Roots, node)
+			continue
+		}
+
+		parent := t.nodes[category.Parent]
+		if parent == nil || parent == node {
+			t.Orphans = append(t.Orphans, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Categories that are not reachable from roots or orphans are part of a cycle.
+	// The cycle is broken at the category with the lowest ID, which becomes an orphan.
+	reachable := map[int]bool{}
+	var mark func(nodes []*CategoryNode)
+	mark = func(nodes []*CategoryNode) {
+		for _, node := range nodes {
+			reachable[node.ID] = true
+			mark(node.Children)
+		}
+	}
+	mark(t.Roots)
+	mark(t.Orphans)
+	ids := make([]int, 0, len(t.nodes))
+	for id := range t.nodes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if reachable[id] {
+			continue
+		}
+
+		node := t.nodes[id]
+		parent := node.Parent
+		for i, child := range parent.Children {
+			if child == node {
+				parent.Children = append(parent.Children[:i], parent.Children[i+1:]...)
+				break
+			}
+		}
+		node.Parent = nil
+		t.Orphans = append(t.Orphans, node)
+		mark([]*CategoryNode{node})
+	}
+
+	sortCategories(t.Roots)
+	sortCategories(t.Orphans)
+	for _, node := range t.nodes {
+		sortCategories(node.Children)
+	}
+	return t
+}
+
+func sortCategories(nodes []*CategoryNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].MenuOrder != nodes[j].MenuOrder {
+			return nodes[i].MenuOrder < nodes[j].MenuOrder
+		}
+		return strings.ToLower(nodes[i].Name) < strings.ToLower(nodes[j].Name)
+	})
+}
+
+// Node returns the category with the given ID, or nil if there is no such category.
+func (t *CategoryTree) Node(id int) *CategoryNode {
+	return t.nodes[id]
+}
+
+// Find returns the category with the given path of names, starting with a top level category.
+// Names are compared case-insensitively. Nil is returned if there is no such category.
+func (t *CategoryTree) Find(path ...string) *CategoryNode {
+	nodes := t.Roots
+	var found *CategoryNode
+	for _, name := range path {
+		found = nil
+		for _, node := range nodes {
+			if strings.EqualFold(node.Name, name) {
+				found = node
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		nodes = found.Children
+	}
+	return found
+}
+
+// Walk calls fn for all categories reachable from roots, parents before their children.
+// Orphans and their children are walked after them.
+func (t *CategoryTree) Walk(fn func(node *CategoryNode)) {
+	var walk func(nodes []*CategoryNode)
+	walk = func(nodes []*CategoryNode) {
+		for _, node := range nodes {
+			fn(node)
+			walk(node.Children)
+		}
+	}
+	walk(t.Roots)
+	walk(t.Orphans)
+}
+
+// CategoryMove is a category whose parent differs between two trees.
+type CategoryMove struct {
+	// From is the category in the tree on which Diff was called.
+	From *CategoryNode
+	// To is the category in the tree that was passed to Diff.
+	To *CategoryNode
+}
+
+// CategoryDiff holds differences between two category trees.
+type CategoryDiff struct {
+	// Added are categories of the other tree that do not exist in this tree.
+	Added []*CategoryNode
+	// Removed are categories of this tree that do not exist in the other tree.
+	Removed []*CategoryNode
+	// Moved are categories that have different parents in the trees.
+	Moved []CategoryMove
+}
+
+// Diff compares the tree with another tree, for instance the category hierarchy of an external system.
+// Categories are matched by their slugs, since IDs of the other tree may be unrelated to woocommerce IDs.
+// A category is moved if its parent has a different slug in the other tree or if it is an orphan in just one of them.
+// Categories are listed in the order of Walk.
+func (t *CategoryTree) Diff(other *CategoryTree) CategoryDiff {
+	var diff CategoryDiff
+
+	slugs := t.bySlug()
+	otherSlugs := other.bySlug()
+
+	t.Walk(func(node *CategoryNode) {
+		o, ok := otherSlugs[node.Slug]
+		if !ok {
+			diff.Removed = append(diff.Removed, node)
+			return
+		}
+		if parentKey(t, node) != parentKey(other, o) {
+			diff.Moved = append(diff.Moved, CategoryMove{From: node, To: o})
+		}
+	})
+	other.Walk(func(node *CategoryNode) {
+		if _, ok := slugs[node.Slug]; !ok {
+			diff.Added = append(diff.Added, node)
+		}
+	})
+
+	return diff
+}
+
+func (t *CategoryTree) bySlug() map[string]*CategoryNode {
+	slugs := make(map[string]*CategoryNode, len(t.nodes))
+	t.Walk(func(node *CategoryNode) {
+		slugs[node.Slug] = node
+	})
+	return slugs
+}
+
+// parentKey identifies the parent of the category across trees.
+func parentKey(t *CategoryTree, node *CategoryNode) string {
+	if node.Parent != nil {
+		return "slug:" + node.Parent.Slug
+	}
+	for _, orphan := range t.Orphans {
+		if orphan == node {
+			return "orphan"
+		}
+	}
+	return "root"
+}
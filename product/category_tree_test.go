@@ -0,0 +1,70 @@
+package product
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+func TestNewCategoryTree(t *testing.T) {
+	tree := NewCategoryTree([]*woocommerce.ProductCategory{
+		{ID: 1, Name: "Clothing", Slug: "clothing"},
+		{ID: 2, Name: "Shirts", Slug: "shirts", Parent: 1},
+		{ID: 3, Name: "Accessories", Slug: "accessories", Parent: 1},
+		{ID: 4, Name: "Caps", Slug: "caps", Parent: 3, MenuOrder: 1},
+		{ID: 5, Name: "Belts", Slug: "belts", Parent: 3, MenuOrder: 2},
+		{ID: 6, Name: "Lost", Slug: "lost", Parent: 99},
+		{ID: 7, Name: "A", Slug: "a", Parent: 8},
+		{ID: 8, Name: "B", Slug: "b", Parent: 7},
+	})
+
+	var walked []int
+	tree.Walk(func(node *CategoryNode) {
+		walked = append(walked, node.ID)
+	})
+	// Siblings are sorted by menu order and name, orphans follow the roots.
+	if expected := []int{1, 3, 4, 5, 2, 7, 8, 6}; !reflect.DeepEqual(walked, expected) {
+		t.Errorf("expected walk %v, got %v", expected, walked)
+	}
+
+	if len(tree.Orphans) != 2 || tree.Orphans[0].ID != 7 || tree.Orphans[1].ID != 6 {
+		t.Errorf("unexpected orphans: %+v", tree.Orphans)
+	}
+	if node := tree.Node(8); node.Parent.ID != 7 || node.Depth() != 1 {
+		t.Errorf("expected the cycle to be broken at the lowest ID, got parent %+v", node.Parent)
+	}
+	if path := tree.Node(5).SlugPath(); !reflect.DeepEqual(path, []string{"clothing", "accessories", "belts"}) {
+		t.Errorf("unexpected slug path: %v", path)
+	}
+	if tree.Find("Clothing", "Missing") != nil {
+		t.Error("expected no category for missing path")
+	}
+}
+
+func TestCategoryTree_Diff(t *testing.T) {
+	store := NewCategoryTree([]*woocommerce.ProductCategory{
+		{ID: 10, Name: "Clothing", Slug: "clothing"},
+		{ID: 11, Name: "Shirts", Slug: "shirts", Parent: 10},
+		{ID: 12, Name: "Caps", Slug: "caps", Parent: 10},
+		{ID: 13, Name: "Old", Slug: "old"},
+	})
+	// IDs of the external hierarchy are unrelated to store IDs.
+	erp := NewCategoryTree([]*woocommerce.ProductCategory{
+		{ID: 1, Name: "Clothing", Slug: "clothing"},
+		{ID: 2, Name: "Shirts", Slug: "shirts", Parent: 1},
+		{ID: 3, Name: "Accessories", Slug: "accessories"},
+		{ID: 4, Name: "Caps", Slug: "caps", Parent: 3},
+	})
+
+	diff := store.Diff(erp)
+	if len(diff.Added) != 1 || diff.Added[0].Slug != "accessories" {
+		t.Errorf("unexpected added categories: %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].ID != 13 {
+		t.Errorf("unexpected removed categories: %+v", diff.Removed)
+	}
+	if len(diff.Moved) != 1 || diff.Moved[0].From.ID != 12 || diff.Moved[0].To.Parent.Slug != "accessories" {
+		t.Errorf("unexpected moved categories: %+v", diff.Moved)
+	}
+}
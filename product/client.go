@@ -1,18 +1,23 @@
 package product
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/zerodays/woocommerce-go"
 	"github.com/zerodays/woocommerce-go/internal/backend"
 )
 
 const (
-	pathList          = "/products"
-	pathRetrieve      = "/products/%d"
-	pathListVariation = "/products/%d/variations"
+	pathList           = "/products"
+	pathRetrieve       = "/products/%d"
+	pathListVariation  = "/products/%d/variations"
+	pathVariation      = "/products/%d/variations/%d"
+	pathBatchVariation = "/products/%d/variations/batch"
+	pathBatch          = "/products/batch"
 )
 
 // Client is the API client used for working with products.
@@ -25,23 +30,51 @@ const (
 // you can create your own type that embeds the woocommerce.Product or woocommerce.ProductVariation
 //
 //	type and add additional fields.
-type Client[P, PV any] struct {
-	backend *backend.Backend
+//
+// Generic types PU and PVU represent partial updates of products and product variations.
+// Default implementations are woocommerce.ProductUpdate and woocommerce.ProductVariationUpdate.
+// Types that embed them can add fields of extensions to updates.
+type Client[P, PV, PU, PVU any] struct {
+	backend woocommerce.Backend
+
+	// Attributes is the client used for working with global product attributes and their terms.
+	Attributes *AttributeClient
+	// Categories is the client used for working with product categories.
+	Categories *CategoryClient
+	// Tags is the client used for working with product tags.
+	Tags *TaxonomyClient[woocommerce.ProductTag, woocommerce.ProductTagUpdate]
+	// ShippingClasses is the client used for working with shipping classes of products.
+	ShippingClasses *TaxonomyClient[woocommerce.ShippingClass, woocommerce.ShippingClassUpdate]
+	// Reviews is the client used for working with product reviews.
+	Reviews *ReviewClient
 }
 
 // New creates a new client for products.
 // It should not be called directly.
 // Instead, client.API should be used.
-func New[P, PV any](backend *backend.Backend) *Client[P, PV] {
-	return &Client[P, PV]{
-		backend: backend,
+func New[P, PV, PU, PVU any](backend woocommerce.Backend) *Client[P, PV, PU, PVU] {
+	return &Client[P, PV, PU, PVU]{
+		backend:    backend,
+		Attributes: newAttributeClient(backend),
+		Categories: &CategoryClient{
+			TaxonomyClient: TaxonomyClient[woocommerce.ProductCategory, woocommerce.ProductCategoryUpdate]{backend: backend, path: pathCategories, name: "product category"},
+		},
+		Tags:            &TaxonomyClient[woocommerce.ProductTag, woocommerce.ProductTagUpdate]{backend: backend, path: pathTags, name: "product tag"},
+		ShippingClasses: &TaxonomyClient[woocommerce.ShippingClass, woocommerce.ShippingClassUpdate]{backend: backend, path: pathShippingClasses, name: "shipping class"},
+		Reviews:         &ReviewClient{backend: backend},
 	}
 }
 
 // List lists products with given parameters.
-func (c Client[P, PV]) List(parameters woocommerce.Parameters) ([]P, error) {
+// Parameters can be ListParams.
+func (c Client[P, PV, PU, PVU]) List(parameters woocommerce.Parameters) ([]P, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]P, error) {
 	// Execute authenticated request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,11 +90,24 @@ func (c Client[P, PV]) List(parameters woocommerce.Parameters) ([]P, error) {
 	return products, nil
 }
 
+// Pager returns a pager that walks all pages of products with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client[P, PV, PU, PVU]) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[P] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[P], error) {
+		return backend.ListPage[P](ctx, c.backend, pathList, parameters)
+	}, options...)
+}
+
 // ListVariations lists product variations for a given product.
-func (c Client[P, PV]) ListVariations(productID int, parameters woocommerce.Parameters) ([]PV, error) {
+func (c Client[P, PV, PU, PVU]) ListVariations(productID int, parameters woocommerce.Parameters) ([]PV, error) {
+	return c.ListVariationsContext(context.Background(), productID, parameters)
+}
+
+// ListVariationsContext is the same as ListVariations, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) ListVariationsContext(ctx context.Context, productID int, parameters woocommerce.Parameters) ([]PV, error) {
 	// Execute authenticated request.
 	path := fmt.Sprintf(pathListVariation, productID)
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, path, nil, parameters, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, parameters, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -77,13 +123,145 @@ func (c Client[P, PV]) ListVariations(productID int, parameters woocommerce.Para
 	return variations, nil
 }
 
+// VariationsPager returns a pager that walks all pages of product variations for a given product.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client[P, PV, PU, PVU]) VariationsPager(productID int, parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[PV] {
+	path := fmt.Sprintf(pathListVariation, productID)
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[PV], error) {
+		return backend.ListPage[PV](ctx, c.backend, path, parameters)
+	}, options...)
+}
+
+// RetrieveVariation retrieves a single variation of a product.
+func (c Client[P, PV, PU, PVU]) RetrieveVariation(productID, variationID int) (PV, error) {
+	return c.RetrieveVariationContext(context.Background(), productID, variationID)
+}
+
+// RetrieveVariationContext is the same as RetrieveVariation, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) RetrieveVariationContext(ctx context.Context, productID, variationID int) (PV, error) {
+	var variation PV
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathVariation, productID, variationID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return variation, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&variation)
+	if err != nil {
+		return variation, fmt.Errorf("[woocommerce-go]: could not unmarshal product variation json: %w", err)
+	}
+
+	return variation, nil
+}
+
+// CreateVariation creates a new variation of a variable product.
+func (c Client[P, PV, PU, PVU]) CreateVariation(productID int, variation *PV) (PV, error) {
+	return c.CreateVariationContext(context.Background(), productID, variation)
+}
+
+// CreateVariationContext is the same as CreateVariation, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) CreateVariationContext(ctx context.Context, productID int, variation *PV) (PV, error) {
+	var created PV
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathListVariation, productID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, path, variation, nil, nil)
+	if err != nil {
+		return created, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return created, fmt.Errorf("[woocommerce-go]: could not unmarshal product variation json: %w", err)
+	}
+
+	return created, nil
+}
+
+// UpdateVariation updates a variation of a product. Only fields that are set on the update are changed.
+func (c Client[P, PV, PU, PVU]) UpdateVariation(productID, variationID int, update PVU) (PV, error) {
+	return c.UpdateVariationContext(context.Background(), productID, variationID, update)
+}
+
+// UpdateVariationContext is the same as UpdateVariation, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) UpdateVariationContext(ctx context.Context, productID, variationID int, update PVU) (PV, error) {
+	var updated PV
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathVariation, productID, variationID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, update, nil, nil)
+	if err != nil {
+		return updated, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+	if err != nil {
+		return updated, fmt.Errorf("[woocommerce-go]: could not unmarshal product variation json: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteVariation permanently deletes a variation of a product and returns the deleted variation.
+// Woocommerce does not support moving variations to trash.
+func (c Client[P, PV, PU, PVU]) DeleteVariation(productID, variationID int) (PV, error) {
+	return c.DeleteVariationContext(context.Background(), productID, variationID)
+}
+
+// DeleteVariationContext is the same as DeleteVariation, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) DeleteVariationContext(ctx context.Context, productID, variationID int) (PV, error) {
+	var deleted PV
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathVariation, productID, variationID)
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return deleted, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&deleted)
+	if err != nil {
+		return deleted, fmt.Errorf("[woocommerce-go]: could not unmarshal product variation json: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// BatchVariations creates, updates and deletes multiple variations of a product.
+// Batches larger than woocommerce.MaxBatchSize are split into multiple requests.
+// Variations that could not be processed are reported by the Err method of the response.
+func (c Client[P, PV, PU, PVU]) BatchVariations(productID int, request woocommerce.BatchRequest[PV, PVU]) (*woocommerce.BatchResponse[PV], error) {
+	return c.BatchVariationsContext(context.Background(), productID, request)
+}
+
+// BatchVariationsContext is the same as BatchVariations, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) BatchVariationsContext(ctx context.Context, productID int, request woocommerce.BatchRequest[PV, PVU]) (*woocommerce.BatchResponse[PV], error) {
+	return backend.Batch[PV, PVU, PV](ctx, c.backend, fmt.Sprintf(pathBatchVariation, productID), request)
+}
+
 // Retrieve retrieves a single product by its ID.
-func (c Client[P, PV]) Retrieve(productID int) (P, error) {
+func (c Client[P, PV, PU, PVU]) Retrieve(productID int) (P, error) {
+	return c.RetrieveContext(context.Background(), productID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) RetrieveContext(ctx context.Context, productID int) (P, error) {
 	var product P
 
 	// Execute authenticated request.
 	path := fmt.Sprintf(pathRetrieve, productID)
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
 	if err != nil {
 		return product, err
 	}
@@ -97,3 +275,93 @@ func (c Client[P, PV]) Retrieve(productID int) (P, error) {
 
 	return product, nil
 }
+
+// Create creates a new product.
+func (c Client[P, PV, PU, PVU]) Create(product *P) (P, error) {
+	return c.CreateContext(context.Background(), product)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) CreateContext(ctx context.Context, product *P) (P, error) {
+	var created P
+
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPost, pathList, product, nil, nil)
+	if err != nil {
+		return created, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return created, fmt.Errorf("[woocommerce-go]: could not unmarshal product json: %w", err)
+	}
+
+	return created, nil
+}
+
+// Update updates the product with a given ID. Only fields that are set on the update are changed.
+func (c Client[P, PV, PU, PVU]) Update(productID int, update PU) (P, error) {
+	return c.UpdateContext(context.Background(), productID, update)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) UpdateContext(ctx context.Context, productID int, update PU) (P, error) {
+	var updated P
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRetrieve, productID)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, update, nil, nil)
+	if err != nil {
+		return updated, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+	if err != nil {
+		return updated, fmt.Errorf("[woocommerce-go]: could not unmarshal product json: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Delete deletes the product with a given ID and returns the deleted product.
+// If force is false, the product is moved to trash. Otherwise, it is permanently deleted.
+func (c Client[P, PV, PU, PVU]) Delete(productID int, force bool) (P, error) {
+	return c.DeleteContext(context.Background(), productID, force)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) DeleteContext(ctx context.Context, productID int, force bool) (P, error) {
+	var deleted P
+
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRetrieve, productID)
+	parameters := woocommerce.BaseParameters{"force": {strconv.FormatBool(force)}}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodDelete, path, nil, parameters, nil)
+	if err != nil {
+		return deleted, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	err = json.NewDecoder(resp.Body).Decode(&deleted)
+	if err != nil {
+		return deleted, fmt.Errorf("[woocommerce-go]: could not unmarshal product json: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// Batch creates, updates and deletes multiple products. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Products that could not be processed are reported by the Err method of the response.
+func (c Client[P, PV, PU, PVU]) Batch(request woocommerce.BatchRequest[P, PU]) (*woocommerce.BatchResponse[P], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) BatchContext(ctx context.Context, request woocommerce.BatchRequest[P, PU]) (*woocommerce.BatchResponse[P], error) {
+	return backend.Batch[P, PU, P](ctx, c.backend, pathBatch, request)
+}
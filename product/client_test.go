@@ -0,0 +1,201 @@
+package product
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+// extendedProduct is a product with a field added by an extension.
+type extendedProduct struct {
+	woocommerce.Product
+
+	GTIN string `json:"global_unique_id,omitempty"`
+}
+
+// extendedProductUpdate is a partial update of extendedProduct.
+type extendedProductUpdate struct {
+	woocommerce.ProductUpdate
+
+	GTIN *string `json:"global_unique_id,omitempty"`
+}
+
+func TestClient_Lifecycle(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[extendedProduct, woocommerce.ProductVariation, extendedProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	product := extendedProduct{GTIN: "4006381333931"}
+	product.Name = "Hoodie"
+	product.RegularPrice = woocommerce.NullFloat{Float: 45, Valid: true}
+	product.ManageStock = true
+	product.StockQuantity = woocommerce.Ptr(3)
+	product.Weight = "0.5"
+	product.Dimensions = &woocommerce.ProductDimensions{Length: "30", Width: "20", Height: "5"}
+	product.Categories = []woocommerce.ProductTermRef{{ID: 9}}
+	product.Attributes = []woocommerce.ProductAttribute{{Name: "Size", Visible: true, Options: []string{"S", "M"}}}
+
+	created, err := client.Create(&product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.GTIN != product.GTIN || created.Price.Float != 45 || created.StockStatus != woocommerce.StockStatusInStock {
+		t.Errorf("unexpected created product: %+v", created)
+	}
+	if created.Dimensions == nil || created.Dimensions.Height != "5" || len(created.Attributes) != 1 || created.Attributes[0].Options[1] != "M" {
+		t.Errorf("unexpected created product: %+v", created)
+	}
+
+	// Fields that are not set are not changed.
+	var update extendedProductUpdate
+	update.SalePrice = woocommerce.SetPrice(40)
+	update.StockQuantity = woocommerce.Ptr(0)
+	update.Featured = woocommerce.Ptr(true)
+	updated, err := client.Update(created.ID, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Hoodie" || updated.RegularPrice.Float != 45 || updated.Price.Float != 40 || !updated.OnSale || !updated.Featured {
+		t.Errorf("unexpected updated product: %+v", updated)
+	}
+	if updated.StockStatus != woocommerce.StockStatusOutOfStock || updated.GTIN != product.GTIN {
+		t.Errorf("unexpected updated product: %+v", updated)
+	}
+
+	// Fields can be unset and prices removed. Fields of extensions are updated as well.
+	update = extendedProductUpdate{GTIN: woocommerce.Ptr("4006381333948")}
+	update.ManageStock = woocommerce.Ptr(false)
+	update.Featured = woocommerce.Ptr(false)
+	update.SalePrice = woocommerce.RemovePrice()
+	update.MenuOrder = woocommerce.Ptr(0)
+	updated, err = client.Update(created.ID, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ManageStock || updated.Featured || updated.OnSale || updated.SalePrice.Valid || updated.Price.Float != 45 || updated.RegularPrice.Float != 45 || updated.GTIN != "4006381333948" {
+		t.Errorf("unexpected updated product: %+v", updated)
+	}
+
+	// Products are moved to trash unless deletion is forced.
+	if _, err := client.Delete(created.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	trashed, err := client.Retrieve(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed.Status != "trash" {
+		t.Errorf("expected trashed product, got status %q", trashed.Status)
+	}
+	if _, err := client.Delete(created.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *woocommerce.Error
+	if _, err := client.Retrieve(created.ID); !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_ProductTypes(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	simple, err := client.Create(&woocommerce.Product{Name: "Cap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grouped, err := client.Create(&woocommerce.Product{
+		Name:            "Accessories",
+		Type:            woocommerce.ProductTypeGrouped,
+		GroupedProducts: []int{simple.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grouped.GroupedProducts) != 1 || grouped.GroupedProducts[0] != simple.ID {
+		t.Errorf("unexpected grouped products: %v", grouped.GroupedProducts)
+	}
+
+	external, err := client.Create(&woocommerce.Product{
+		Name:        "Poster",
+		Type:        woocommerce.ProductTypeExternal,
+		ExternalURL: "https://example.com/poster",
+		ButtonText:  "Buy at Example",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := client.Retrieve(external.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.Type != woocommerce.ProductTypeExternal || retrieved.ExternalURL != "https://example.com/poster" || retrieved.ButtonText != "Buy at Example" {
+		t.Errorf("unexpected external product: %+v", retrieved)
+	}
+}
+
+func TestClient_Variations(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	product, err := client.Create(&woocommerce.Product{Name: "Shirt", Type: woocommerce.ProductTypeVariable})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.CreateVariation(product.ID, &woocommerce.ProductVariation{
+		ProductCommon: woocommerce.ProductCommon{SKU: "SHIRT-S", RegularPrice: woocommerce.NullFloat{Float: 20, Valid: true}},
+		ManageStock:   woocommerce.ManageStockEnabled,
+		Attributes:    []woocommerce.ProductVariationAttribute{{Name: "Size", Option: "S"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.ManageStock != woocommerce.ManageStockEnabled || created.Attributes[0].Option != "S" {
+		t.Errorf("unexpected created variation: %+v", created)
+	}
+
+	updated, err := client.UpdateVariation(product.ID, created.ID, woocommerce.ProductVariationUpdate{
+		ProductCommonUpdate: woocommerce.ProductCommonUpdate{SalePrice: woocommerce.SetPrice(15)},
+		ManageStock:         woocommerce.Ptr(woocommerce.ManageStockDisabled),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.SKU != "SHIRT-S" || updated.Price.Float != 15 || updated.ManageStock != woocommerce.ManageStockDisabled {
+		t.Errorf("unexpected updated variation: %+v", updated)
+	}
+
+	response, err := client.BatchVariations(product.ID, woocommerce.BatchRequest[woocommerce.ProductVariation, woocommerce.ProductVariationUpdate]{
+		Create: []woocommerce.ProductVariation{{ProductCommon: woocommerce.ProductCommon{SKU: "SHIRT-M"}}},
+		Update: []woocommerce.BatchUpdate[woocommerce.ProductVariationUpdate]{{ID: created.ID, Update: woocommerce.ProductVariationUpdate{
+			ProductCommonUpdate: woocommerce.ProductCommonUpdate{Description: woocommerce.Ptr("Small")},
+		}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if response.Update[0].Object.Description != "Small" {
+		t.Errorf("unexpected batch response: %+v", response)
+	}
+
+	if _, err := client.DeleteVariation(product.ID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := client.Retrieve(product.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved.Variations) != 1 || retrieved.Variations[0] != response.Create[0].ID {
+		t.Errorf("unexpected variations of the product: %v", retrieved.Variations)
+	}
+}
@@ -0,0 +1,216 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// VariationMatrix describes all variations of a variable product.
+// A variation is generated for each combination of options of the variation attributes.
+//
+// Attribute names and options are compared case-insensitively.
+type VariationMatrix struct {
+	// Attributes are attributes of the product. Only attributes with the Variation field set are used.
+	Attributes []woocommerce.ProductAttribute
+	// SKU is the template of SKUs of variations. Attribute names in braces, such as {Size} or {size},
+	// are replaced with options of the variation. If SKU is empty, variations have no SKU.
+	SKU string
+	// RegularPrice is the regular price of variations before price rules are applied.
+	// If it is not valid, variations have no price and price rules are ignored.
+	RegularPrice woocommerce.NullFloat
+	// PriceRules adjust regular prices of variations with given options.
+	PriceRules []PriceRule
+}
+
+// PriceRule adjusts the regular price of variations with the option of an attribute.
+type PriceRule struct {
+	// Attribute is the name of the attribute.
+	Attribute string
+	Option    string
+	// Adjustment is added to the regular price. Negative adjustments lower the price.
+	Adjustment woocommerce.Float
+}
+
+// Variations returns variations for all combinations of attribute options.
+// Variations are ordered by options of the first attribute, then the second one and so on.
+func (m VariationMatrix) Variations() []woocommerce.ProductVariation {
+	attributes := m.variationAttributes()
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	combinations := [][]woocommerce.ProductVariationAttribute{nil}
+	for _, attribute := range attributes {
+		var next [][]woocommerce.ProductVariationAttribute
+		for _, combination := range combinations {
+			for _, option := range attribute.Options {
+				c := append(append([]woocommerce.ProductVariationAttribute{}, combination...), woocommerce.ProductVariationAttribute{
+					ID:     attribute.ID,
+					Name:   attribute.Name,
+					Option: option,
+				})
+				next = append(next, c)
+			}
+		}
+		combinations = next
+	}
+
+	variations := make([]woocommerce.ProductVariation, len(combinations))
+	for i, combination := range combinations {
+		variations[i].Attributes = combination
+		variations[i].SKU = m.sku(combination)
+		variations[i].RegularPrice = m.price(combination)
+	}
+	return variations
+}
+
+func (m VariationMatrix) sku(combination []woocommerce.ProductVariationAttribute) string {
+	if m.SKU == "" {
+		return ""
+	}
+
+	options := make(map[string]string, len(combination))
+	for _, attribute := range combination {
+		options[strings.ToLower(attribute.Name)] = strings.ReplaceAll(attribute.Option, " ", "-")
+	}
+
+	// Placeholders that are not names of attributes are kept as they are.
+	var b strings.Builder
+	template := m.SKU
+	for {
+		end := strings.Index(template, "}")
+		if end < 0 {
+			break
+		}
+		start := strings.LastIndex(template[:end], "{")
+		if start < 0 {
+			b.WriteString(template[:end+1])
+			template = template[end+1:]
+			continue
+		}
+
+		b.WriteString(template[:start])
+		if option, ok := options[strings.ToLower(template[start+1:end])]; ok {
+			b.WriteString(option)
+		} else {
+			b.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	b.WriteString(template)
+	return b.String()
+}
+
+func (m VariationMatrix) price(combination []woocommerce.ProductVariationAttribute) woocommerce.NullFloat {
+	price := m.RegularPrice
+	if !price.Valid {
+		return price
+	}
+
+	for _, rule := range m.PriceRules {
+		for _, attribute := range combination {
+			if strings.EqualFold(rule.Attribute, attribute.Name) && strings.EqualFold(rule.Option, attribute.Option) {
+				price.Float += rule.Adjustment
+			}
+		}
+	}
+	return price
+}
+
+// DiffVariations compares variations of the matrix with existing variations of the product.
+// The returned batch creates missing variations and deletes variations that are not in the matrix.
+// Variations that match a combination of the matrix are not changed, even if their SKU or price differ.
+// Existing variations with attributes that match any option are deleted, as are
+// duplicated variations with the same combination, except the oldest one.
+func (c Client[P, PV, PU, PVU]) DiffVariations(productID int, matrix VariationMatrix) (woocommerce.BatchRequest[woocommerce.ProductVariation, woocommerce.ProductVariationUpdate], error) {
+	return c.DiffVariationsContext(context.Background(), productID, matrix)
+}
+
+// DiffVariationsContext is the same as DiffVariations, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) DiffVariationsContext(ctx context.Context, productID int, matrix VariationMatrix) (woocommerce.BatchRequest[woocommerce.ProductVariation, woocommerce.ProductVariationUpdate], error) {
+	var request woocommerce.BatchRequest[woocommerce.ProductVariation, woocommerce.ProductVariationUpdate]
+
+	// Existing variations are read as woocommerce.ProductVariation, so that their attributes are known for any PV.
+	path := fmt.Sprintf(pathListVariation, productID)
+	existing, err := woocommerce.NewPager(nil, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[woocommerce.ProductVariation], error) {
+		return backend.ListPage[woocommerce.ProductVariation](ctx, c.backend, path, parameters)
+	}).Collect(ctx)
+	if err != nil {
+		return request, err
+	}
+
+	variations := matrix.Variations()
+	wanted := make(map[string]bool, len(variations))
+	for _, variation := range variations {
+		wanted[combinationKey(variation.Attributes)] = true
+	}
+
+	// Of variations with duplicated combinations, the oldest one is kept.
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].ID < existing[j].ID
+	})
+	found := map[string]bool{}
+	for _, variation := range existing {
+		key := combinationKey(variation.Attributes)
+		if !wanted[key] || found[key] {
+			request.Delete = append(request.Delete, variation.ID)
+			continue
+		}
+		found[key] = true
+	}
+
+	for _, variation := range variations {
+		if !found[combinationKey(variation.Attributes)] {
+			request.Create = append(request.Create, variation)
+		}
+	}
+
+	return request, nil
+}
+
+// SyncVariations creates missing variations of the matrix and deletes variations that are not in it.
+// Changes are computed by DiffVariations and applied in batch requests. If there are no changes, no batch request is sent.
+func (c Client[P, PV, PU, PVU]) SyncVariations(productID int, matrix VariationMatrix) (*woocommerce.BatchResponse[PV], error) {
+	return c.SyncVariationsContext(context.Background(), productID, matrix)
+}
+
+// SyncVariationsContext is the same as SyncVariations, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) SyncVariationsContext(ctx context.Context, productID int, matrix VariationMatrix) (*woocommerce.BatchResponse[PV], error) {
+	request, err := c.DiffVariationsContext(ctx, productID, matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(pathBatchVariation, productID)
+	return backend.Batch[woocommerce.ProductVariation, woocommerce.ProductVariationUpdate, PV](ctx, c.backend, path, request)
+}
+
+func (m VariationMatrix) variationAttributes() []woocommerce.ProductAttribute {
+	var attributes []woocommerce.ProductAttribute
+	for _, attribute := range m.Attributes {
+		if attribute.Variation {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// combinationKey returns a key that identifies the combination of options regardless of the order of attributes.
+// Global attributes are identified by their IDs and custom attributes by their names.
+func combinationKey(attributes []woocommerce.ProductVariationAttribute) string {
+	parts := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		name := strings.ToLower(attribute.Name)
+		if attribute.ID != 0 {
+			name = fmt.Sprintf("#%d", attribute.ID)
+		}
+		parts[i] = name + "=" + strings.ToLower(attribute.Option)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "\x00")
+}
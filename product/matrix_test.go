@@ -0,0 +1,117 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+var testMatrix = VariationMatrix{
+	Attributes: []woocommerce.ProductAttribute{
+		{ID: 1, Name: "Color", Variation: true, Options: []string{"Blue", "Light Green"}},
+		{Name: "Material", Options: []string{"Cotton"}},
+		{Name: "Size", Variation: true, Options: []string{"S", "M", "XL"}},
+	},
+	SKU:          "HOODIE-{Color}-{Size}",
+	RegularPrice: woocommerce.NullFloat{Float: 40, Valid: true},
+	PriceRules: []PriceRule{
+		{Attribute: "size", Option: "xl", Adjustment: 5},
+		{Attribute: "Color", Option: "Light Green", Adjustment: -2},
+	},
+}
+
+func TestVariationMatrix_Variations(t *testing.T) {
+	variations := testMatrix.Variations()
+	if len(variations) != 6 {
+		t.Fatalf("expected 6 variations, got %d", len(variations))
+	}
+
+	last := variations[5]
+	if last.SKU != "HOODIE-Light-Green-XL" || last.RegularPrice.Float != 43 {
+		t.Errorf("unexpected variation: %+v", last)
+	}
+	if len(last.Attributes) != 2 || last.Attributes[0].ID != 1 || last.Attributes[1].Option != "XL" {
+		t.Errorf("unexpected attributes: %+v", last.Attributes)
+	}
+	if variations[0].SKU != "HOODIE-Blue-S" || variations[0].RegularPrice.Float != 40 {
+		t.Errorf("unexpected variation: %+v", variations[0])
+	}
+
+	if variations := (VariationMatrix{Attributes: testMatrix.Attributes[1:2]}).Variations(); variations != nil {
+		t.Errorf("expected no variations without variation attributes, got %+v", variations)
+	}
+}
+
+func TestVariationMatrix_SKU(t *testing.T) {
+	matrix := VariationMatrix{
+		Attributes: testMatrix.Attributes,
+		SKU:        "{{color}-{SIZE}-{Material}-{unknown}",
+	}
+	variations := matrix.Variations()
+	if sku := variations[5].SKU; sku != "{Light-Green-XL-{Material}-{unknown}" {
+		t.Errorf("unexpected sku %s", sku)
+	}
+}
+
+func TestClient_SyncVariations(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	product, err := client.Create(&woocommerce.Product{Name: "Hoodie", Type: woocommerce.ProductTypeVariable, Attributes: testMatrix.Attributes})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An existing variation that is kept, one that is not in the matrix and a duplicate.
+	keep := woocommerce.ProductVariation{Attributes: []woocommerce.ProductVariationAttribute{{Name: "size", Option: "m"}, {ID: 1, Name: "Color", Option: "blue"}}}
+	kept, err := client.CreateVariation(product.ID, &keep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateVariation(product.ID, &keep); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateVariation(product.ID, &woocommerce.ProductVariation{Attributes: []woocommerce.ProductVariationAttribute{{Name: "Size", Option: "XXL"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := client.DiffVariations(product.ID, testMatrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Create) != 5 || len(diff.Delete) != 2 {
+		t.Errorf("expected 5 creates and 2 deletes, got %d and %d", len(diff.Create), len(diff.Delete))
+	}
+
+	response, err := client.SyncVariations(product.ID, testMatrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	variations, err := client.VariationsPager(product.ID, nil).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variations) != 6 {
+		t.Errorf("expected 6 variations, got %d", len(variations))
+	}
+	if _, err := client.RetrieveVariation(product.ID, kept.ID); err != nil {
+		t.Errorf("expected matching variation to be kept: %v", err)
+	}
+
+	// A second sync has nothing to do.
+	diff, err = client.DiffVariations(product.ID, testMatrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.Len() != 0 {
+		t.Errorf("expected no changes, got %+v", diff)
+	}
+}
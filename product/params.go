@@ -0,0 +1,193 @@
+package product
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// OrderBy is the attribute by which products are sorted.
+type OrderBy string
+
+const (
+	OrderByDate       OrderBy = "date"
+	OrderByModified   OrderBy = "modified"
+	OrderByID         OrderBy = "id"
+	OrderByInclude    OrderBy = "include"
+	OrderByTitle      OrderBy = "title"
+	OrderBySlug       OrderBy = "slug"
+	OrderByPrice      OrderBy = "price"
+	OrderByPopularity OrderBy = "popularity"
+	OrderByRating     OrderBy = "rating"
+	OrderByMenuOrder  OrderBy = "menu_order"
+)
+
+// ListParams are parameters for listing products. Zero values are omitted from the request,
+// so woocommerce defaults are used for them.
+//
+// Dates are formatted in the location of the time values, which should be the timezone of the store.
+// If DatesAreGMT is set, dates are converted to UTC and woocommerce compares them with GMT dates of products.
+type ListParams struct {
+	woocommerce.PageParams
+
+	// Search limits results to products matching the string.
+	Search string
+	// Category limits results to products in any of the categories with given IDs.
+	Category []int
+	// Tag limits results to products with any of the tags with given IDs.
+	Tag []int
+	// SKU limits results to products with any of the given SKUs.
+	SKU []string
+	// Type limits results to products of the type.
+	Type woocommerce.ProductType
+	// Status limits results to products with the status. By default, products with any status except trash are listed.
+	Status woocommerce.ProductStatus
+	// Featured limits results to featured or not featured products.
+	Featured *bool
+	// OnSale limits results to products that are or are not on sale.
+	OnSale *bool
+	// MinPrice and MaxPrice limit results to products with prices in the range.
+	MinPrice woocommerce.NullFloat
+	MaxPrice woocommerce.NullFloat
+	// StockStatus limits results to products with the stock status.
+	StockStatus woocommerce.StockStatus
+	// Attribute limits results to products with the global attribute, for instance pa_color.
+	Attribute string
+	// AttributeTerm limits results to products with the term of Attribute with the given ID.
+	AttributeTerm int
+
+	After          time.Time
+	Before         time.Time
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+	DatesAreGMT    bool
+
+	Include []int
+	Exclude []int
+	// Parent limits results to products with given parent IDs.
+	Parent []int
+
+	OrderBy OrderBy
+	Order   woocommerce.SortOrder
+}
+
+func (p ListParams) Values() url.Values {
+	values := p.PageParams.Values()
+
+	backend.SetString(values, "search", p.Search)
+	backend.SetInts(values, "category", p.Category)
+	backend.SetInts(values, "tag", p.Tag)
+	backend.SetStrings(values, "sku", p.SKU)
+	backend.SetString(values, "type", string(p.Type))
+	backend.SetString(values, "status", string(p.Status))
+	if p.Featured != nil {
+		values.Set("featured", strconv.FormatBool(*p.Featured))
+	}
+	if p.OnSale != nil {
+		values.Set("on_sale", strconv.FormatBool(*p.OnSale))
+	}
+	if p.MinPrice.Valid {
+		values.Set("min_price", strconv.FormatFloat(float64(p.MinPrice.Float), 'f', -1, 64))
+	}
+	if p.MaxPrice.Valid {
+		values.Set("max_price", strconv.FormatFloat(float64(p.MaxPrice.Float), 'f', -1, 64))
+	}
+	backend.SetString(values, "stock_status", string(p.StockStatus))
+	backend.SetString(values, "attribute", p.Attribute)
+	backend.SetInt(values, "attribute_term", p.AttributeTerm)
+
+	backend.SetDate(values, "after", p.After, p.DatesAreGMT)
+	backend.SetDate(values, "before", p.Before, p.DatesAreGMT)
+	backend.SetDate(values, "modified_after", p.ModifiedAfter, p.DatesAreGMT)
+	backend.SetDate(values, "modified_before", p.ModifiedBefore, p.DatesAreGMT)
+	if p.DatesAreGMT {
+		values.Set("dates_are_gmt", "true")
+	}
+
+	backend.SetInts(values, "include", p.Include)
+	backend.SetInts(values, "exclude", p.Exclude)
+	backend.SetInts(values, "parent", p.Parent)
+
+	backend.SetString(values, "orderby", string(p.OrderBy))
+	backend.SetString(values, "order", string(p.Order))
+	return values
+}
+
+// Validate checks the parameters for values that woocommerce would reject
+// and for combinations that can not match any product.
+func (p ListParams) Validate() error {
+	if err := p.PageParams.Validate(); err != nil {
+		return err
+	}
+	if err := p.Order.Validate(); err != nil {
+		return err
+	}
+
+	switch p.OrderBy {
+	case "", OrderByDate, OrderByModified, OrderByID, OrderByInclude, OrderByTitle, OrderBySlug,
+		OrderByPrice, OrderByPopularity, OrderByRating, OrderByMenuOrder:
+	default:
+		return fmt.Errorf("%w: unknown orderby value %q", woocommerce.ErrInvalidParameters, p.OrderBy)
+	}
+	if p.OrderBy == OrderByInclude && len(p.Include) == 0 {
+		return fmt.Errorf("%w: orderby include requires include to be set", woocommerce.ErrInvalidParameters)
+	}
+
+	switch p.Type {
+	case "", woocommerce.ProductTypeSimple, woocommerce.ProductTypeGrouped, woocommerce.ProductTypeExternal, woocommerce.ProductTypeVariable:
+	default:
+		return fmt.Errorf("%w: unknown product type %q", woocommerce.ErrInvalidParameters, p.Type)
+	}
+	switch p.Status {
+	case "", woocommerce.ProductStatusAny, woocommerce.ProductStatusDraft, woocommerce.ProductStatusPending,
+		woocommerce.ProductStatusPrivate, woocommerce.ProductStatusPublish, woocommerce.ProductStatusFuture, woocommerce.ProductStatusTrash:
+	default:
+		return fmt.Errorf("%w: unknown product status %q", woocommerce.ErrInvalidParameters, p.Status)
+	}
+	switch p.StockStatus {
+	case "", woocommerce.StockStatusInStock, woocommerce.StockStatusOutOfStock, woocommerce.StockStatusOnBackorder:
+	default:
+		return fmt.Errorf("%w: unknown stock status %q", woocommerce.ErrInvalidParameters, p.StockStatus)
+	}
+
+	for _, sku := range p.SKU {
+		if sku == "" || strings.Contains(sku, ",") {
+			return fmt.Errorf("%w: invalid sku %q", woocommerce.ErrInvalidParameters, sku)
+		}
+	}
+
+	if p.MinPrice.Valid && p.MinPrice.Float < 0 || p.MaxPrice.Valid && p.MaxPrice.Float < 0 {
+		return fmt.Errorf("%w: prices must not be negative", woocommerce.ErrInvalidParameters)
+	}
+	if p.MinPrice.Valid && p.MaxPrice.Valid && p.MinPrice.Float > p.MaxPrice.Float {
+		return fmt.Errorf("%w: min_price must not be greater than max_price", woocommerce.ErrInvalidParameters)
+	}
+
+	if p.AttributeTerm < 0 {
+		return fmt.Errorf("%w: attribute_term must not be negative, got %d", woocommerce.ErrInvalidParameters, p.AttributeTerm)
+	}
+	if p.AttributeTerm != 0 && p.Attribute == "" {
+		return fmt.Errorf("%w: attribute_term requires attribute to be set", woocommerce.ErrInvalidParameters)
+	}
+	if p.Attribute != "" && !strings.HasPrefix(p.Attribute, "pa_") {
+		return fmt.Errorf("%w: attribute must be a global attribute slug starting with pa_, got %q", woocommerce.ErrInvalidParameters, p.Attribute)
+	}
+
+	if !p.After.IsZero() && !p.Before.IsZero() && !p.After.Before(p.Before) {
+		return fmt.Errorf("%w: after must be before before", woocommerce.ErrInvalidParameters)
+	}
+	if !p.ModifiedAfter.IsZero() && !p.ModifiedBefore.IsZero() && !p.ModifiedAfter.Before(p.ModifiedBefore) {
+		return fmt.Errorf("%w: modified_after must be before modified_before", woocommerce.ErrInvalidParameters)
+	}
+
+	if id, ok := backend.Overlap(p.Include, p.Exclude); ok {
+		return fmt.Errorf("%w: product %d is both included and excluded", woocommerce.ErrInvalidParameters, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package product
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestListParams_Values(t *testing.T) {
+	onSale := false
+	params := ListParams{
+		PageParams:    woocommerce.PageParams{PerPage: 20},
+		Category:      []int{4, 7},
+		SKU:           []string{"HOODIE-S", "HOODIE-M"},
+		Type:          woocommerce.ProductTypeVariable,
+		OnSale:        &onSale,
+		MinPrice:      woocommerce.NullFloat{Float: 9.5, Valid: true},
+		Attribute:     "pa_color",
+		AttributeTerm: 12,
+		ModifiedAfter: time.Date(2024, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+		DatesAreGMT:   true,
+		OrderBy:       OrderByPrice,
+		Order:         woocommerce.SortOrderAsc,
+	}
+
+	expected := "attribute=pa_color&attribute_term=12&category=4%2C7&dates_are_gmt=true&min_price=9.5&modified_after=2024-03-01T11%3A00%3A00&on_sale=false&order=asc&orderby=price&per_page=20&sku=HOODIE-S%2CHOODIE-M&type=variable"
+	if got := params.Values().Encode(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	if got := (ListParams{}).Values().Encode(); got != "" {
+		t.Errorf("expected empty parameters, got %s", got)
+	}
+}
+
+func TestListParams_Validate(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name   string
+		params ListParams
+		valid  bool
+	}{
+		{"empty", ListParams{}, true},
+		{"any status", ListParams{Status: woocommerce.ProductStatusAny, Type: woocommerce.ProductTypeSimple}, true},
+		{"unknown status", ListParams{Status: "published"}, false},
+		{"unknown type", ListParams{Type: "bundle"}, false},
+		{"unknown stock status", ListParams{StockStatus: "available"}, false},
+		{"unknown orderby", ListParams{OrderBy: "sales"}, false},
+		{"orderby include without include", ListParams{OrderBy: OrderByInclude}, false},
+		{"sku with comma", ListParams{SKU: []string{"A,B"}}, false},
+		{"negative price", ListParams{MinPrice: woocommerce.NullFloat{Float: -1, Valid: true}}, false},
+		{"price range", ListParams{MinPrice: woocommerce.NullFloat{Float: 10, Valid: true}, MaxPrice: woocommerce.NullFloat{Float: 10, Valid: true}}, true},
+		{"inverted price range", ListParams{MinPrice: woocommerce.NullFloat{Float: 20, Valid: true}, MaxPrice: woocommerce.NullFloat{Float: 10, Valid: true}}, false},
+		{"term without attribute", ListParams{AttributeTerm: 3}, false},
+		{"local attribute", ListParams{Attribute: "color"}, false},
+		{"after is after before", ListParams{After: now, Before: now.Add(-time.Hour)}, false},
+		{"include and exclude", ListParams{Include: []int{1}, Exclude: []int{1}}, false},
+	}
+
+	for _, c := range cases {
+		err := c.params.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if !c.valid && !errors.Is(err, woocommerce.ErrInvalidParameters) {
+			t.Errorf("%s: expected ErrInvalidParameters, got %v", c.name, err)
+		}
+	}
+}
+
+func TestClient_ListParams(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	products := []woocommerce.Product{
+		{Name: "Hoodie", ProductCommon: woocommerce.ProductCommon{SKU: "HOODIE", RegularPrice: woocommerce.NullFloat{Float: 45, Valid: true}}},
+		{Name: "Beanie", ProductCommon: woocommerce.ProductCommon{SKU: "BEANIE", RegularPrice: woocommerce.NullFloat{Float: 20, Valid: true}, SalePrice: woocommerce.NullFloat{Float: 15, Valid: true}}},
+		{Name: "Cap", ProductCommon: woocommerce.ProductCommon{SKU: "CAP", RegularPrice: woocommerce.NullFloat{Float: 10, Valid: true}}},
+	}
+	for i := range products {
+		if _, err := client.Create(&products[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	onSale := true
+	cases := []struct {
+		params   ListParams
+		expected []string
+	}{
+		{ListParams{SKU: []string{"HOODIE", "CAP"}, OrderBy: OrderByTitle, Order: woocommerce.SortOrderAsc}, []string{"Cap", "Hoodie"}},
+		{ListParams{OnSale: &onSale}, []string{"Beanie"}},
+		{ListParams{MinPrice: woocommerce.NullFloat{Float: 12, Valid: true}, MaxPrice: woocommerce.NullFloat{Float: 50, Valid: true}, OrderBy: OrderByTitle, Order: woocommerce.SortOrderAsc}, []string{"Beanie", "Hoodie"}},
+	}
+	for _, c := range cases {
+		found, err := client.List(c.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, p := range found {
+			names = append(names, p.Name)
+		}
+		if len(names) != len(c.expected) {
+			t.Errorf("expected %v for %+v, got %v", c.expected, c.params, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != c.expected[i] {
+				t.Errorf("expected %v for %+v, got %v", c.expected, c.params, names)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathReviews     = "/products/reviews"
+	pathReviewEdit  = "/products/reviews/%d"
+	pathReviewBatch = "/products/reviews/batch"
+)
+
+// ReviewOrderBy is the attribute by which reviews are sorted.
+type ReviewOrderBy string
+
+const (
+	ReviewOrderByDate    ReviewOrderBy = "date"
+	ReviewOrderByDateGMT ReviewOrderBy = "date_gmt"
+	ReviewOrderByID      ReviewOrderBy = "id"
+	ReviewOrderByInclude ReviewOrderBy = "include"
+	ReviewOrderByProduct ReviewOrderBy = "product"
+)
+
+// ReviewListParams are parameters for listing product reviews. Zero values are omitted from the request,
+// so woocommerce defaults are used for them.
+//
+// Dates are formatted in the location of the time values, which should be the timezone of the store.
+type ReviewListParams struct {
+	woocommerce.PageParams
+
+	// Search limits results to reviews matching the string.
+	Search string
+	// Product limits results to reviews of products with given IDs.
+	Product []int
+	// Status limits results to reviews with the status. By default, woocommerce lists approved reviews.
+	Status woocommerce.ReviewStatus
+	// ReviewerEmail limits results to reviews written by the email.
+	ReviewerEmail string
+	// Reviewer limits results to reviews written by customers with given IDs.
+	Reviewer        []int
+	ReviewerExclude []int
+
+	After  time.Time
+	Before time.Time
+
+	Include []int
+	Exclude []int
+
+	OrderBy ReviewOrderBy
+	Order   woocommerce.SortOrder
+}
+
+func (p ReviewListParams) Values() url.Values {
+	values := p.PageParams.Values()
+
+	backend.SetString(values, "search", p.Search)
+	backend.SetInts(values, "product", p.Product)
+	backend.SetString(values, "status", string(p.Status))
+	backend.SetString(values, "reviewer_email", p.ReviewerEmail)
+	backend.SetInts(values, "reviewer", p.Reviewer)
+	backend.SetInts(values, "reviewer_exclude", p.ReviewerExclude)
+
+	backend.SetDate(values, "after", p.After, false)
+	backend.SetDate(values, "before", p.Before, false)
+
+	backend.SetInts(values, "include", p.Include)
+	backend.SetInts(values, "exclude", p.Exclude)
+
+	backend.SetString(values, "orderby", string(p.OrderBy))
+	backend.SetString(values, "order", string(p.Order))
+	return values
+}
+
+// Validate checks the parameters for values that woocommerce would reject
+// and for combinations that can not match any review.
+func (p ReviewListParams) Validate() error {
+	if err := p.PageParams.Validate(); err != nil {
+		return err
+	}
+	if err := p.Order.Validate(); err != nil {
+		return err
+	}
+
+	switch p.Status {
+	case "", woocommerce.ReviewStatusAll, woocommerce.ReviewStatusApproved, woocommerce.ReviewStatusHold,
+		woocommerce.ReviewStatusSpam, woocommerce.ReviewStatusTrash:
+	default:
+		return fmt.Errorf("%w: unknown review status %q", woocommerce.ErrInvalidParameters, p.Status)
+	}
+
+	switch p.OrderBy {
+	case "", ReviewOrderByDate, ReviewOrderByDateGMT, ReviewOrderByID, ReviewOrderByInclude, ReviewOrderByProduct:
+	default:
+		return fmt.Errorf("%w: unknown orderby value %q", woocommerce.ErrInvalidParameters, p.OrderBy)
+	}
+	if p.OrderBy == ReviewOrderByInclude && len(p.Include) == 0 {
+		return fmt.Errorf("%w: orderby include requires include to be set", woocommerce.ErrInvalidParameters)
+	}
+
+	if !p.After.IsZero() && !p.Before.IsZero() && !p.After.Before(p.Before) {
+		return fmt.Errorf("%w: after must be before before", woocommerce.ErrInvalidParameters)
+	}
+
+	if id, ok := backend.Overlap(p.Include, p.Exclude); ok {
+		return fmt.Errorf("%w: review %d is both included and excluded", woocommerce.ErrInvalidParameters, id)
+	}
+	if id, ok := backend.Overlap(p.Reviewer, p.ReviewerExclude); ok {
+		return fmt.Errorf("%w: reviewer %d is both included and excluded", woocommerce.ErrInvalidParameters, id)
+	}
+
+	return nil
+}
+
+// ReviewClient is the API client used for working with product reviews.
+// It should not be initialized directly. Use Client.Reviews instead.
+type ReviewClient struct {
+	backend woocommerce.Backend
+}
+
+// List returns a list of reviews with given parameters and total review count.
+// Parameters can be ReviewListParams.
+func (c ReviewClient) List(parameters woocommerce.Parameters) ([]*woocommerce.ProductReview, int, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c ReviewClient) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]*woocommerce.ProductReview, int, error) {
+	page, err := backend.ListPage[*woocommerce.ProductReview](ctx, c.backend, pathReviews, parameters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return page.Items, page.Total, nil
+}
+
+// Pager returns a pager that walks all pages of reviews with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c ReviewClient) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.ProductReview] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.ProductReview], error) {
+		return backend.ListPage[*woocommerce.ProductReview](ctx, c.backend, pathReviews, parameters)
+	}, options...)
+}
+
+// Retrieve retrieves a single review by its ID.
+func (c ReviewClient) Retrieve(reviewID int) (*woocommerce.ProductReview, error) {
+	return c.RetrieveContext(context.Background(), reviewID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c ReviewClient) RetrieveContext(ctx context.Context, reviewID int) (*woocommerce.ProductReview, error) {
+	return c.request(ctx, http.MethodGet, fmt.Sprintf(pathReviewEdit, reviewID), nil, nil)
+}
+
+// Create creates a new review. ProductID, Review, Reviewer and ReviewerEmail must be set.
+func (c ReviewClient) Create(review *woocommerce.ProductReview) (*woocommerce.ProductReview, error) {
+	return c.CreateContext(context.Background(), review)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c ReviewClient) CreateContext(ctx context.Context, review *woocommerce.ProductReview) (*woocommerce.ProductReview, error) {
+	return c.request(ctx, http.MethodPost, pathReviews, review, nil)
+}
+
+// Update updates the review with a given ID.
+func (c ReviewClient) Update(reviewID int, review *woocommerce.ProductReview) (*woocommerce.ProductReview, error) {
+	return c.UpdateContext(context.Background(), reviewID, review)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c ReviewClient) UpdateContext(ctx context.Context, reviewID int, review *woocommerce.ProductReview) (*woocommerce.ProductReview, error) {
+	return c.request(ctx, http.MethodPut, fmt.Sprintf(pathReviewEdit, reviewID), review, nil)
+}
+
+// Delete deletes the review with a given ID and returns the deleted review.
+// If force is false, the review is moved to trash. Otherwise, it is permanently deleted.
+func (c ReviewClient) Delete(reviewID int, force bool) (*woocommerce.ProductReview, error) {
+	return c.DeleteContext(context.Background(), reviewID, force)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c ReviewClient) DeleteContext(ctx context.Context, reviewID int, force bool) (*woocommerce.ProductReview, error) {
+	parameters := woocommerce.BaseParameters{"force": {strconv.FormatBool(force)}}
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf(pathReviewEdit, reviewID), nil, parameters)
+}
+
+// Batch creates, updates and deletes multiple reviews. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Reviews that could not be processed are reported by the Err method of the response.
+func (c ReviewClient) Batch(request woocommerce.BatchRequest[woocommerce.ProductReview, woocommerce.ProductReview]) (*woocommerce.BatchResponse[*woocommerce.ProductReview], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c ReviewClient) BatchContext(ctx context.Context, request woocommerce.BatchRequest[woocommerce.ProductReview, woocommerce.ProductReview]) (*woocommerce.BatchResponse[*woocommerce.ProductReview], error) {
+	return backend.Batch[woocommerce.ProductReview, woocommerce.ProductReview, *woocommerce.ProductReview](ctx, c.backend, pathReviewBatch, request)
+}
+
+// Approve approves the review with a given ID, so that it is shown in the store.
+func (c ReviewClient) Approve(reviewID int) (*woocommerce.ProductReview, error) {
+	return c.ApproveContext(context.Background(), reviewID)
+}
+
+// ApproveContext is the same as Approve, but it uses the given context for the request.
+func (c ReviewClient) ApproveContext(ctx context.Context, reviewID int) (*woocommerce.ProductReview, error) {
+	return c.moderate(ctx, reviewID, woocommerce.ReviewStatusApproved)
+}
+
+// Hold puts the review with a given ID on hold, so that it is hidden until it is approved.
+func (c ReviewClient) Hold(reviewID int) (*woocommerce.ProductReview, error) {
+	return c.HoldContext(context.Background(), reviewID)
+}
+
+// HoldContext is the same as Hold, but it uses the given context for the request.
+func (c ReviewClient) HoldContext(ctx context.Context, reviewID int) (*woocommerce.ProductReview, error) {
+	return c.moderate(ctx, reviewID, woocommerce.ReviewStatusHold)
+}
+
+// Spam marks the review with a given ID as spam.
+func (c ReviewClient) Spam(reviewID int) (*woocommerce.ProductReview, error) {
+	return c.SpamContext(context.Background(), reviewID)
+}
+
+// SpamContext is the same as Spam, but it uses the given context for the request.
+func (c ReviewClient) SpamContext(ctx context.Context, reviewID int) (*woocommerce.ProductReview, error) {
+	return c.moderate(ctx, reviewID, woocommerce.ReviewStatusSpam)
+}
+
+// Trash moves the review with a given ID to trash.
+func (c ReviewClient) Trash(reviewID int) (*woocommerce.ProductReview, error) {
+	return c.TrashContext(context.Background(), reviewID)
+}
+
+// TrashContext is the same as Trash, but it uses the given context for the request.
+func (c ReviewClient) TrashContext(ctx context.Context, reviewID int) (*woocommerce.ProductReview, error) {
+	return c.moderate(ctx, reviewID, woocommerce.ReviewStatusTrash)
+}
+
+func (c ReviewClient) moderate(ctx context.Context, reviewID int, status woocommerce.ReviewStatus) (*woocommerce.ProductReview, error) {
+	return c.UpdateContext(ctx, reviewID, &woocommerce.ProductReview{Status: status})
+}
+
+func (c ReviewClient) request(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*woocommerce.ProductReview, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var review woocommerce.ProductReview
+	err = json.NewDecoder(resp.Body).Decode(&review)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal product review json: %w", err)
+	}
+
+	return &review, nil
+}
@@ -0,0 +1,151 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestReviewListParams_Values(t *testing.T) {
+	params := ReviewListParams{
+		PageParams:    woocommerce.PageParams{PerPage: 20},
+		Product:       []int{3, 4},
+		Status:        woocommerce.ReviewStatusHold,
+		ReviewerEmail: "jane@example.com",
+		After:         time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+		OrderBy:       ReviewOrderByProduct,
+	}
+
+	expected := "after=2024-05-01T08%3A00%3A00&orderby=product&per_page=20&product=3%2C4&reviewer_email=jane%40example.com&status=hold"
+	if encoded := params.Values().Encode(); encoded != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+}
+
+func TestReviewListParams_Validate(t *testing.T) {
+	invalid := []ReviewListParams{
+		{Status: "pending"},
+		{OrderBy: "rating"},
+		{OrderBy: ReviewOrderByInclude},
+		{Reviewer: []int{1}, ReviewerExclude: []int{1}},
+		{After: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Before: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, params := range invalid {
+		if err := params.Validate(); !errors.Is(err, woocommerce.ErrInvalidParameters) {
+			t.Errorf("expected ErrInvalidParameters for %+v, got %v", params, err)
+		}
+	}
+
+	if err := (ReviewListParams{Status: woocommerce.ReviewStatusAll, Product: []int{1}}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestReviewClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	products := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	client := products.Reviews
+
+	hoodie, err := products.Create(&woocommerce.Product{Name: "Hoodie"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beanie, err := products.Create(&woocommerce.Product{Name: "Beanie"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Create(&woocommerce.ProductReview{ProductID: hoodie.ID, Review: "Nice"}); err == nil {
+		t.Error("expected error for missing reviewer")
+	}
+
+	created, err := client.Create(&woocommerce.ProductReview{
+		ProductID:     hoodie.ID,
+		Review:        "Warm and soft.",
+		Reviewer:      "Jane",
+		ReviewerEmail: "jane@example.com",
+		Rating:        5,
+		Verified:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Status != woocommerce.ReviewStatusApproved || created.ProductName != "Hoodie" || created.Rating != 5 || !created.Verified {
+		t.Errorf("unexpected created review: %+v", created)
+	}
+
+	var request woocommerce.BatchRequest[woocommerce.ProductReview, woocommerce.ProductReview]
+	for _, email := range []string{"john@example.com", "spam@example.com"} {
+		request.Create = append(request.Create, woocommerce.ProductReview{ProductID: beanie.ID, Review: "Beanie review", Reviewer: "Someone", ReviewerEmail: email, Rating: 3})
+	}
+	response, err := client.Batch(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Err(); err != nil {
+		t.Fatal(err)
+	}
+	john, spam := response.Create[0].ID, response.Create[1].ID
+
+	held, err := client.Hold(john)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if held.Status != woocommerce.ReviewStatusHold || held.Rating != 3 {
+		t.Errorf("unexpected held review: %+v", held)
+	}
+	if _, err := client.Spam(spam); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only approved reviews are listed by default.
+	reviews, total, err := client.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || reviews[0].ID != created.ID {
+		t.Errorf("expected only the approved review, got %+v", reviews)
+	}
+	reviews, total, err = client.List(ReviewListParams{Product: []int{beanie.ID}, Status: woocommerce.ReviewStatusAll})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || reviews[0].ID != john {
+		t.Errorf("expected the held review, got %+v", reviews)
+	}
+	reviews, _, err = client.List(ReviewListParams{ReviewerEmail: "spam@example.com", Status: woocommerce.ReviewStatusSpam})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews) != 1 || reviews[0].ID != spam {
+		t.Errorf("expected the spam review, got %+v", reviews)
+	}
+
+	if _, err := client.Approve(john); err != nil {
+		t.Fatal(err)
+	}
+	trashed, err := client.Trash(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed.Status != woocommerce.ReviewStatusTrash {
+		t.Errorf("expected trashed review, got %+v", trashed)
+	}
+	if _, err := client.Delete(spam, true); err != nil {
+		t.Fatal(err)
+	}
+
+	reviews, err = client.Pager(nil).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews) != 1 || reviews[0].ID != john {
+		t.Errorf("expected the approved review, got %+v", reviews)
+	}
+}
@@ -0,0 +1,406 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+// DefaultLowStockAmount is the default low stock threshold of woocommerce stores.
+// It is used when neither the product nor its parent set the low stock amount.
+const DefaultLowStockAmount = 2
+
+// DefaultStockAttempts is the number of times a stock update is attempted when stock is modified concurrently.
+const DefaultStockAttempts = 5
+
+var (
+	// ErrStockConflict is returned when stock was modified concurrently in all attempts of a stock update.
+	ErrStockConflict = errors.New("[woocommerce-go]: stock was modified concurrently")
+	// ErrStockNotManaged is returned when stock of a product that does not manage stock is adjusted.
+	ErrStockNotManaged = errors.New("[woocommerce-go]: stock is not managed")
+)
+
+// StockTransition is a change of stock that the store reports to its administrators.
+type StockTransition string
+
+const (
+	StockTransitionNone StockTransition = ""
+	// StockTransitionLow means that stock dropped to the low stock amount or below it.
+	StockTransitionLow StockTransition = "low"
+	// StockTransitionOut means that the product went out of stock.
+	StockTransitionOut StockTransition = "out"
+	// StockTransitionRestocked means that the product was out of stock and is now in stock.
+	StockTransitionRestocked StockTransition = "restocked"
+)
+
+// StockChange is the result of a stock update.
+type StockChange struct {
+	// ID is the ID of the product or variation whose stock was requested to be updated.
+	ID int
+	// ParentID is the ID of the parent product of a variation. It is 0 for products.
+	// For variations whose stock is managed by the parent product, stock of the parent was updated.
+	ParentID int
+	// Previous is the stock quantity before the update. It is nil if stock was not managed.
+	Previous *int
+	Current  int
+	// LowStockAmount is the low stock threshold that was used to compute the transition.
+	LowStockAmount int
+	Transition     StockTransition
+	// Attempts is the number of attempts that were needed because of concurrent modifications.
+	Attempts int
+	// Err is the error of the update of a single product by BulkSetStock.
+	Err error
+}
+
+// StockOption configures stock updates.
+type StockOption func(*stockOptions)
+
+type stockOptions struct {
+	attempts       int
+	lowStockAmount int
+}
+
+// WithStockAttempts sets the number of times a stock update is attempted when stock is modified concurrently.
+func WithStockAttempts(attempts int) StockOption {
+	return func(o *stockOptions) {
+		o.attempts = attempts
+	}
+}
+
+// WithLowStockAmount sets the low stock threshold of the store,
+// which is used when neither the product nor its parent set the low stock amount.
+func WithLowStockAmount(amount int) StockOption {
+	return func(o *stockOptions) {
+		o.lowStockAmount = amount
+	}
+}
+
+// stockState holds the stock fields of a product or a variation.
+type stockState struct {
+	ID              int                     `json:"id"`
+	ParentID        int                     `json:"parent_id"`
+	Type            string                  `json:"type"`
+	ManageStock     woocommerce.ManageStock `json:"manage_stock"`
+	StockQuantity   *int                    `json:"stock_quantity"`
+	LowStockAmount  *int                    `json:"low_stock_amount"`
+	DateModifiedGMT string                  `json:"date_modified_gmt"`
+}
+
+// stockUpdate sets the stock quantity of a product or a variation.
+type stockUpdate struct {
+	ManageStock   bool `json:"manage_stock"`
+	StockQuantity int  `json:"stock_quantity"`
+}
+
+// AdjustStock adds delta to the stock quantity of the product or variation with a given ID.
+// Negative deltas reduce stock. Variations are found by their IDs, without the ID of the parent product.
+// If stock of a variation is managed by its parent product, stock of the parent is adjusted.
+// If stock is not managed, ErrStockNotManaged is returned.
+//
+// Woocommerce does not support conditional updates, so the product is read again right before it is updated.
+// If its modification date or stock quantity changed since the new quantity was computed, the quantity is computed
+// again and the update is attempted again. The product is also read after the update, since the modification date
+// has a precision of one second and another process may have overwritten the update with a quantity it computed earlier.
+// If that happened, the update is attempted again as well. If all attempts conflict, ErrStockConflict is returned.
+//
+// A modification that is saved in the short time between the last read and the update is overwritten
+// and can not be detected if it is saved within the same second and the quantities match.
+func (c Client[P, PV, PU, PVU]) AdjustStock(id, delta int, options ...StockOption) (*StockChange, error) {
+	return c.AdjustStockContext(context.Background(), id, delta, options...)
+}
+
+// AdjustStockContext is the same as AdjustStock, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) AdjustStockContext(ctx context.Context, id, delta int, options ...StockOption) (*StockChange, error) {
+	return c.updateStock(ctx, id, func(state *stockState) (int, error) {
+		if state.StockQuantity == nil || state.ManageStock == woocommerce.ManageStockDisabled {
+			return 0, fmt.Errorf("%w: product %d", ErrStockNotManaged, state.ID)
+		}
+		return *state.StockQuantity + delta, nil
+	}, options)
+}
+
+// SetStock sets the stock quantity of the product or variation with a given ID and enables stock management.
+// It is read again before and after it is updated in the same way as by AdjustStock,
+// so that the previous quantity and the transition are reported correctly.
+func (c Client[P, PV, PU, PVU]) SetStock(id, quantity int, options ...StockOption) (*StockChange, error) {
+	return c.SetStockContext(context.Background(), id, quantity, options...)
+}
+
+// SetStockContext is the same as SetStock, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) SetStockContext(ctx context.Context, id, quantity int, options ...StockOption) (*StockChange, error) {
+	return c.updateStock(ctx, id, func(state *stockState) (int, error) {
+		return quantity, nil
+	}, options)
+}
+
+func (c Client[P, PV, PU, PVU]) updateStock(ctx context.Context, id int, quantity func(state *stockState) (int, error), options []StockOption) (*StockChange, error) {
+	o := newStockOptions(options)
+
+	for attempt := 1; attempt <= o.attempts; attempt++ {
+		state, err := c.stockTarget(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		current, err := quantity(state)
+		if err != nil {
+			return nil, err
+		}
+
+		// Read the product again to detect modifications while the new quantity was computed.
+		again, err := c.readStock(ctx, state.ID)
+		if err != nil {
+			return nil, err
+		}
+		if again.DateModifiedGMT != state.DateModifiedGMT || !equalQuantity(again.StockQuantity, state.StockQuantity) {
+			continue
+		}
+
+		updated, err := c.writeStock(ctx, state, current)
+		if err != nil {
+			return nil, err
+		}
+
+		// Read the product after the update to detect modifications that were saved after it and may have overwritten it.
+		again, err = c.readStock(ctx, state.ID)
+		if err != nil {
+			return nil, err
+		}
+		if again.DateModifiedGMT != updated.DateModifiedGMT || !equalQuantity(again.StockQuantity, &current) {
+			continue
+		}
+
+		change := newStockChange(id, state)
+		change.Current = current
+		change.LowStockAmount = c.lowStockAmount(ctx, state, o)
+		change.Transition = stockTransition(change.Previous, change.Current, change.LowStockAmount)
+		change.Attempts = attempt
+		return change, nil
+	}
+
+	return nil, fmt.Errorf("%w: product %d in %d attempts", ErrStockConflict, id, o.attempts)
+}
+
+// BulkSetStock sets stock quantities of products and variations, given by a map of IDs to quantities,
+// and enables stock management. Products are read one by one and updated in batch requests.
+// Since quantities are not computed from previous ones, concurrent modifications do not affect them
+// and only previous quantities and transitions of such products may be reported incorrectly.
+//
+// Changes are sorted by IDs. Products that could not be read or updated have the Err field set,
+// as do variations whose stock is managed by a parent product that is updated for a lower ID.
+// An error is returned only if a batch request fails.
+func (c Client[P, PV, PU, PVU]) BulkSetStock(quantities map[int]int, options ...StockOption) ([]*StockChange, error) {
+	return c.BulkSetStockContext(context.Background(), quantities, options...)
+}
+
+// BulkSetStockContext is the same as BulkSetStock, but it uses the given context for the request.
+func (c Client[P, PV, PU, PVU]) BulkSetStockContext(ctx context.Context, quantities map[int]int, options ...StockOption) ([]*StockChange, error) {
+	o := newStockOptions(options)
+
+	ids := make([]int, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	// Group updates by batch paths. Products share a path and variations use paths of their parents.
+	type batch struct {
+		request woocommerce.BatchRequest[stockUpdate, stockUpdate]
+		changes []*StockChange
+		states  []*stockState
+	}
+	batches := map[string]*batch{}
+	var paths []string
+	// targets maps IDs of updated products to IDs in quantities.
+	targets := map[int]int{}
+	changes := make([]*StockChange, 0, len(ids))
+	for _, id := range ids {
+		state, err := c.stockTarget(ctx, id)
+		if err != nil {
+			changes = append(changes, &StockChange{ID: id, Err: err})
+			continue
+		}
+		if other, ok := targets[state.ID]; ok {
+			err := fmt.Errorf("[woocommerce-go]: stock of product %d is managed by product %d, which is updated for %d", id, state.ID, other)
+			change := newStockChange(id, state)
+			change.Err = err
+			changes = append(changes, change)
+			continue
+		}
+		targets[state.ID] = id
+
+		path := pathBatch
+		if state.Type == "variation" {
+			path = fmt.Sprintf(pathBatchVariation, state.ParentID)
+		}
+		b := batches[path]
+		if b == nil {
+			b = &batch{}
+			batches[path] = b
+			paths = append(paths, path)
+		}
+
+		change := newStockChange(id, state)
+		change.Current = quantities[id]
+		change.Attempts = 1
+		b.request.Update = append(b.request.Update, woocommerce.BatchUpdate[stockUpdate]{
+			ID:     state.ID,
+			Update: stockUpdate{ManageStock: true, StockQuantity: quantities[id]},
+		})
+		b.changes = append(b.changes, change)
+		b.states = append(b.states, state)
+		changes = append(changes, change)
+	}
+
+	for _, path := range paths {
+		b := batches[path]
+		response, err := backend.Batch[stockUpdate, stockUpdate, *stockState](ctx, c.backend, path, b.request)
+		if err != nil {
+			return changes, err
+		}
+
+		for i, change := range b.changes {
+			if i >= len(response.Update) {
+				change.Err = fmt.Errorf("[woocommerce-go]: missing batch result of product %d", b.states[i].ID)
+				continue
+			}
+			if item := response.Update[i]; item.Error != nil {
+				change.Err = item.Error
+				continue
+			}
+			updated := response.Update[i].Object
+			if updated.StockQuantity != nil {
+				change.Current = *updated.StockQuantity
+			}
+			change.LowStockAmount = c.lowStockAmount(ctx, b.states[i], o)
+			change.Transition = stockTransition(change.Previous, change.Current, change.LowStockAmount)
+		}
+	}
+
+	return changes, nil
+}
+
+func newStockOptions(options []StockOption) stockOptions {
+	o := stockOptions{
+		attempts:       DefaultStockAttempts,
+		lowStockAmount: DefaultLowStockAmount,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+	if o.attempts < 1 {
+		o.attempts = 1
+	}
+	return o
+}
+
+// newStockChange returns the change of stock of the product or variation with the given ID,
+// whose stock is stored in the given target.
+func newStockChange(id int, target *stockState) *StockChange {
+	change := &StockChange{ID: id, ParentID: target.ParentID, Previous: target.StockQuantity}
+	if target.ID != id {
+		// Stock of the variation is managed by its parent.
+		change.ParentID = target.ID
+	}
+	return change
+}
+
+// stockTarget reads the product or variation whose stock is used by the product or variation with the given ID.
+func (c Client[P, PV, PU, PVU]) stockTarget(ctx context.Context, id int) (*stockState, error) {
+	state, err := c.readStock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if state.Type == "variation" && state.ManageStock == woocommerce.ManageStockParent {
+		return c.readStock(ctx, state.ParentID)
+	}
+	return state, nil
+}
+
+// readStock reads stock of a product or a variation. Woocommerce returns variations from the product endpoint as well.
+func (c Client[P, PV, PU, PVU]) readStock(ctx context.Context, id int) (*stockState, error) {
+	// Execute authenticated request.
+	path := fmt.Sprintf(pathRetrieve, id)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var state stockState
+	err = json.NewDecoder(resp.Body).Decode(&state)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal product json: %w", err)
+	}
+
+	return &state, nil
+}
+
+func (c Client[P, PV, PU, PVU]) writeStock(ctx context.Context, state *stockState, quantity int) (*stockState, error) {
+	path := fmt.Sprintf(pathRetrieve, state.ID)
+	if state.Type == "variation" {
+		path = fmt.Sprintf(pathVariation, state.ParentID, state.ID)
+	}
+
+	// Execute authenticated request.
+	update := stockUpdate{ManageStock: true, StockQuantity: quantity}
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodPut, path, update, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var updated stockState
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal product json: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// lowStockAmount returns the low stock amount of the product. Variations without it use the amount of their parent.
+func (c Client[P, PV, PU, PVU]) lowStockAmount(ctx context.Context, state *stockState, o stockOptions) int {
+	if state.LowStockAmount != nil {
+		return *state.LowStockAmount
+	}
+	if state.Type == "variation" {
+		if parent, err := c.readStock(ctx, state.ParentID); err == nil && parent.LowStockAmount != nil {
+			return *parent.LowStockAmount
+		}
+	}
+	return o.lowStockAmount
+}
+
+// stockTransition returns the transition of stock from the previous to the current quantity.
+// No transition is reported if stock was not managed before.
+func stockTransition(previous *int, current, lowStockAmount int) StockTransition {
+	if previous == nil {
+		return StockTransitionNone
+	}
+
+	switch {
+	case current <= 0 && *previous > 0:
+		return StockTransitionOut
+	case current > 0 && *previous <= 0:
+		return StockTransitionRestocked
+	case current <= lowStockAmount && *previous > lowStockAmount:
+		return StockTransitionLow
+	default:
+		return StockTransitionNone
+	}
+}
+
+func equalQuantity(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
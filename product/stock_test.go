@@ -0,0 +1,253 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func newStockProduct(t *testing.T, client *Client[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate], quantity int) woocommerce.Product {
+	product := woocommerce.Product{Name: "Mug", ManageStock: true}
+	product.StockQuantity = woocommerce.Ptr(quantity)
+	product.LowStockAmount = woocommerce.Ptr(2)
+	created, err := client.Create(&product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return created
+}
+
+func TestClient_AdjustStock(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	product := newStockProduct(t, client, 5)
+
+	steps := []struct {
+		delta      int
+		current    int
+		transition StockTransition
+	}{
+		{-1, 4, StockTransitionNone},
+		{-2, 2, StockTransitionLow},
+		{-2, 0, StockTransitionOut},
+		{3, 3, StockTransitionRestocked},
+	}
+	for _, step := range steps {
+		change, err := client.AdjustStock(product.ID, step.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if change.Current != step.current || change.Transition != step.transition || change.Attempts != 1 {
+			t.Errorf("adjust by %d: unexpected change %+v", step.delta, change)
+		}
+	}
+
+	unmanaged, err := client.Create(&woocommerce.Product{Name: "Sticker"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.AdjustStock(unmanaged.ID, -1); !errors.Is(err, ErrStockNotManaged) {
+		t.Errorf("expected ErrStockNotManaged, got %v", err)
+	}
+
+	// SetStock enables stock management.
+	change, err := client.SetStock(unmanaged.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Previous != nil || change.Current != 10 || change.LowStockAmount != DefaultLowStockAmount {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	retrieved, err := client.Retrieve(unmanaged.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !retrieved.ManageStock || *retrieved.StockQuantity != 10 || retrieved.StockStatus != woocommerce.StockStatusInStock {
+		t.Errorf("unexpected product: %+v", retrieved)
+	}
+}
+
+func TestClient_AdjustStock_Variations(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	parent := newStockProduct(t, client, 20)
+
+	own := woocommerce.ProductVariation{ManageStock: woocommerce.ManageStockEnabled}
+	own.StockQuantity = woocommerce.Ptr(3)
+	variation, err := client.CreateVariation(parent.ID, &own)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared, err := client.CreateVariation(parent.ID, &woocommerce.ProductVariation{ManageStock: woocommerce.ManageStockParent})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The variation uses the low stock amount of its parent.
+	change, err := client.AdjustStock(variation.ID, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.ID != variation.ID || change.ParentID != parent.ID || change.Current != 2 || change.Transition != StockTransitionLow {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	retrieved, err := client.RetrieveVariation(parent.ID, variation.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *retrieved.StockQuantity != 2 {
+		t.Errorf("expected variation stock 2, got %d", *retrieved.StockQuantity)
+	}
+
+	// Stock of the parent is adjusted for variations whose stock is managed by the parent.
+	change, err = client.AdjustStock(shared.ID, -5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.ID != shared.ID || change.ParentID != parent.ID || change.Current != 15 {
+		t.Errorf("unexpected change: %+v", change)
+	}
+}
+
+func TestClient_AdjustStock_Conflict(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	b := backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret)
+	other := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](b)
+	product := newStockProduct(t, other, 10)
+
+	// Another worker sells items between the read and the update of the client (afterRead),
+	// or it reads the product before the update and overwrites the update with its own quantity (afterWrite).
+	afterRead, afterWrite := 0, 0
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](woocommerce.BackendFunc(func(ctx context.Context, apiType woocommerce.APIType, method, path string, body interface{}, parameters woocommerce.Parameters, headers map[string]string) (*http.Response, error) {
+		if method == http.MethodPut && afterWrite > 0 {
+			afterWrite--
+			stale, err := other.Retrieve(product.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := b.AuthenticatedRequestContext(ctx, apiType, method, path, body, parameters, headers)
+			if _, err := other.Update(product.ID, woocommerce.ProductUpdate{ProductCommonUpdate: woocommerce.ProductCommonUpdate{
+				StockQuantity: woocommerce.Ptr(*stale.StockQuantity - 3),
+			}}); err != nil {
+				t.Error(err)
+			}
+			return resp, err
+		}
+
+		resp, err := b.AuthenticatedRequestContext(ctx, apiType, method, path, body, parameters, headers)
+		if method == http.MethodGet && afterRead > 0 {
+			afterRead--
+			if _, err := other.AdjustStock(product.ID, -3); err != nil {
+				t.Error(err)
+			}
+		}
+		return resp, err
+	}))
+
+	stock := func() int {
+		retrieved, err := client.Retrieve(product.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *retrieved.StockQuantity
+	}
+
+	afterRead = 1
+	change, err := client.AdjustStock(product.ID, -2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Attempts != 2 || *change.Previous != 7 || change.Current != 5 {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	if quantity := stock(); quantity != 5 {
+		t.Errorf("expected both adjustments to be applied, got stock %d", quantity)
+	}
+
+	afterWrite = 1
+	change, err = client.AdjustStock(product.ID, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Attempts != 2 || *change.Previous != 2 || change.Current != 1 {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	if quantity := stock(); quantity != 1 {
+		t.Errorf("expected both adjustments to be applied, got stock %d", quantity)
+	}
+
+	// Both reads of both attempts are followed by sales.
+	afterRead = 4
+	if _, err := client.AdjustStock(product.ID, -1, WithStockAttempts(2)); !errors.Is(err, ErrStockConflict) {
+		t.Errorf("expected ErrStockConflict, got %v", err)
+	}
+	if quantity := stock(); quantity != -11 {
+		t.Errorf("expected only concurrent changes to be applied, got stock %d", quantity)
+	}
+}
+
+func TestClient_BulkSetStock(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	first := newStockProduct(t, client, 5)
+	second := newStockProduct(t, client, 0)
+	shared, err := client.CreateVariation(second.ID, &woocommerce.ProductVariation{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shirt, err := client.Create(&woocommerce.Product{Name: "Shirt", Type: woocommerce.ProductTypeVariable})
+	if err != nil {
+		t.Fatal(err)
+	}
+	variation, err := client.CreateVariation(shirt.ID, &woocommerce.ProductVariation{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := client.BulkSetStock(map[int]int{
+		first.ID:     1,
+		second.ID:    8,
+		shared.ID:    3,
+		variation.ID: 4,
+		999:          1,
+	}, WithLowStockAmount(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 5 {
+		t.Fatalf("expected 5 changes, got %d", len(changes))
+	}
+	if changes[0].ID != first.ID || changes[0].Current != 1 || changes[0].Transition != StockTransitionLow {
+		t.Errorf("unexpected change: %+v", changes[0])
+	}
+	if changes[1].ID != second.ID || changes[1].Transition != StockTransitionRestocked {
+		t.Errorf("unexpected change: %+v", changes[1])
+	}
+	// Stock of the shared variation is managed by the second product, which is already updated.
+	if changes[2].ID != shared.ID || changes[2].ParentID != second.ID || changes[2].Err == nil {
+		t.Errorf("expected error for shared stock, got %+v", changes[2])
+	}
+	if changes[3].ID != variation.ID || changes[3].ParentID != shirt.ID || changes[3].Previous != nil || changes[3].Err != nil {
+		t.Errorf("unexpected change: %+v", changes[3])
+	}
+	if changes[4].Err == nil {
+		t.Errorf("expected error for missing product, got %+v", changes[4])
+	}
+
+	retrieved, err := client.RetrieveVariation(shirt.ID, variation.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.ManageStock != woocommerce.ManageStockEnabled || *retrieved.StockQuantity != 4 {
+		t.Errorf("unexpected variation: %+v", retrieved)
+	}
+}
@@ -0,0 +1,150 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathCategories      = "/products/categories"
+	pathTags            = "/products/tags"
+	pathShippingClasses = "/products/shipping_classes"
+)
+
+// TaxonomyClient is the API client used for working with terms of a product taxonomy,
+// such as categories, tags or shipping classes.
+// It should not be initialized directly. Use Client.Categories, Client.Tags or Client.ShippingClasses instead.
+//
+// Generic type T represents the type of the term, for instance woocommerce.ProductTag,
+// and U the type of updates of the term, for instance woocommerce.ProductCategoryUpdate.
+type TaxonomyClient[T, U any] struct {
+	backend woocommerce.Backend
+	// path is the path of the taxonomy, for instance /products/tags.
+	path string
+	// name is the name of terms used in errors.
+	name string
+}
+
+// List returns a list of terms with given parameters and total term count.
+func (c TaxonomyClient[T, U]) List(parameters woocommerce.Parameters) ([]*T, int, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]*T, int, error) {
+	page, err := backend.ListPage[*T](ctx, c.backend, c.path, parameters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return page.Items, page.Total, nil
+}
+
+// Pager returns a pager that walks all pages of terms with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c TaxonomyClient[T, U]) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*T] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*T], error) {
+		return backend.ListPage[*T](ctx, c.backend, c.path, parameters)
+	}, options...)
+}
+
+// Retrieve retrieves a single term by its ID.
+func (c TaxonomyClient[T, U]) Retrieve(id int) (*T, error) {
+	return c.RetrieveContext(context.Background(), id)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) RetrieveContext(ctx context.Context, id int) (*T, error) {
+	return c.request(ctx, http.MethodGet, c.editPath(id), nil, nil)
+}
+
+// Create creates a new term.
+func (c TaxonomyClient[T, U]) Create(term *T) (*T, error) {
+	return c.CreateContext(context.Background(), term)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) CreateContext(ctx context.Context, term *T) (*T, error) {
+	return c.request(ctx, http.MethodPost, c.path, term, nil)
+}
+
+// Update updates the term with a given ID.
+func (c TaxonomyClient[T, U]) Update(id int, update U) (*T, error) {
+	return c.UpdateContext(context.Background(), id, update)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) UpdateContext(ctx context.Context, id int, update U) (*T, error) {
+	return c.request(ctx, http.MethodPut, c.editPath(id), update, nil)
+}
+
+// Delete permanently deletes the term with a given ID and returns the deleted term.
+// Woocommerce does not support moving terms to trash. Children of a deleted category are moved to its parent.
+func (c TaxonomyClient[T, U]) Delete(id int) (*T, error) {
+	return c.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) DeleteContext(ctx context.Context, id int) (*T, error) {
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	return c.request(ctx, http.MethodDelete, c.editPath(id), nil, parameters)
+}
+
+func (c TaxonomyClient[T, U]) editPath(id int) string {
+	return fmt.Sprintf("%s/%d", c.path, id)
+}
+
+func (c TaxonomyClient[T, U]) request(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*T, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var term T
+	err = json.NewDecoder(resp.Body).Decode(&term)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal %s json: %w", c.name, err)
+	}
+
+	return &term, nil
+}
+
+// Batch creates, updates and deletes multiple terms. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Terms that could not be processed are reported by the Err method of the response.
+func (c TaxonomyClient[T, U]) Batch(request woocommerce.BatchRequest[T, U]) (*woocommerce.BatchResponse[*T], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c TaxonomyClient[T, U]) BatchContext(ctx context.Context, request woocommerce.BatchRequest[T, U]) (*woocommerce.BatchResponse[*T], error) {
+	return backend.Batch[T, U, *T](ctx, c.backend, c.path+"/batch", request)
+}
+
+// CategoryClient is the API client used for working with product categories.
+// It should not be initialized directly. Use Client.Categories instead.
+type CategoryClient struct {
+	TaxonomyClient[woocommerce.ProductCategory, woocommerce.ProductCategoryUpdate]
+}
+
+// Tree loads all categories and returns them as a tree.
+func (c CategoryClient) Tree() (*CategoryTree, error) {
+	return c.TreeContext(context.Background())
+}
+
+// TreeContext is the same as Tree, but it uses the given context for the request.
+func (c CategoryClient) TreeContext(ctx context.Context) (*CategoryTree, error) {
+	categories, err := c.Pager(nil).Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCategoryTree(categories), nil
+}
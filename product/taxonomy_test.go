@@ -0,0 +1,143 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestTaxonomyClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	tag, err := client.Tags.Create(&woocommerce.ProductTag{Name: "Summer Sale"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Slug != "summer-sale" {
+		t.Errorf("unexpected created tag: %+v", tag)
+	}
+	if _, err := client.Tags.Create(&woocommerce.ProductTag{Name: "summer sale"}); err == nil {
+		t.Error("expected error for duplicated slug")
+	}
+
+	updated, err := client.Tags.Update(tag.ID, woocommerce.ProductTagUpdate{Description: woocommerce.Ptr("Hot deals")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Summer Sale" || updated.Description != "Hot deals" {
+		t.Errorf("unexpected updated tag: %+v", updated)
+	}
+	updated, err = client.Tags.Update(tag.ID, woocommerce.ProductTagUpdate{Description: woocommerce.Ptr("")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Summer Sale" || updated.Description != "" {
+		t.Errorf("expected description to be removed: %+v", updated)
+	}
+	if _, err := client.Tags.Delete(tag.ID); err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *woocommerce.Error
+	if _, err := client.Tags.Retrieve(tag.ID); !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	var request woocommerce.BatchRequest[woocommerce.ShippingClass, woocommerce.ShippingClassUpdate]
+	for _, name := range []string{"Bulky", "Fragile", "Letter"} {
+		request.Create = append(request.Create, woocommerce.ShippingClass{Name: name})
+	}
+	response, err := client.ShippingClasses.Batch(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Err(); err != nil {
+		t.Fatal(err)
+	}
+	classes, err := client.ShippingClasses.Pager(nil, woocommerce.WithPageSize(2)).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 3 || classes[2].Slug != "letter" {
+		t.Errorf("unexpected shipping classes: %+v", classes)
+	}
+}
+
+func TestCategoryClient_Tree(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New[woocommerce.Product, woocommerce.ProductVariation, woocommerce.ProductUpdate, woocommerce.ProductVariationUpdate](backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret)).Categories
+
+	clothing, err := client.Create(&woocommerce.ProductCategory{Name: "Clothing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shirts, err := client.Create(&woocommerce.ProductCategory{Name: "Shirts", Parent: clothing.ID, MenuOrder: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hoodies, err := client.Create(&woocommerce.ProductCategory{Name: "Hoodies", Parent: clothing.ID, MenuOrder: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zipped, err := client.Create(&woocommerce.ProductCategory{Name: "Zipped", Parent: hoodies.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A category can not be moved below its descendants.
+	if _, err := client.Update(clothing.ID, woocommerce.ProductCategoryUpdate{Parent: woocommerce.Ptr(zipped.ID)}); err == nil {
+		t.Error("expected error for cyclic parent")
+	}
+
+	tree, err := client.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Roots) != 1 || len(tree.Roots[0].Children) != 2 || tree.Roots[0].Children[0].ID != hoodies.ID {
+		t.Fatalf("unexpected tree: %+v", tree.Roots)
+	}
+	if path := tree.Node(zipped.ID).Path(); !reflect.DeepEqual(path, []string{"Clothing", "Hoodies", "Zipped"}) {
+		t.Errorf("unexpected path: %v", path)
+	}
+
+	// Children of deleted categories are moved to the parent.
+	if _, err := client.Delete(hoodies.ID); err != nil {
+		t.Fatal(err)
+	}
+	tree, err = client.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node := tree.Find("clothing", "zipped"); node == nil || node.Parent.ID != clothing.ID {
+		t.Errorf("expected zipped to be moved to clothing, got %+v", node)
+	}
+	if node := tree.Find("Clothing", "Shirts"); node == nil || node.ID != shirts.ID {
+		t.Errorf("expected to find shirts, got %+v", node)
+	}
+
+	// Categories can be moved to the top level and their menu order can be reset.
+	moved, err := client.Update(shirts.ID, woocommerce.ProductCategoryUpdate{Parent: woocommerce.Ptr(0), MenuOrder: woocommerce.Ptr(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.Parent != 0 || moved.MenuOrder != 0 {
+		t.Errorf("expected shirts at the top level, got %+v", moved)
+	}
+	tree, err = client.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node := tree.Find("Shirts"); node == nil || node.Parent != nil || node.Depth() != 0 {
+		t.Errorf("expected shirts to be a root, got %+v", node)
+	}
+	if len(tree.Roots) != 2 || len(tree.Node(clothing.ID).Children) != 1 {
+		t.Errorf("unexpected tree: %+v", tree.Roots)
+	}
+}
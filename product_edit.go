@@ -0,0 +1,97 @@
+package woocommerce
+
+import "encoding/json"
+
+// PriceUpdate sets or removes a price in a partial update of a product.
+// It is created with SetPrice or RemovePrice.
+type PriceUpdate struct {
+	price  Float
+	remove bool
+}
+
+// SetPrice returns an update that sets the price.
+func SetPrice(price Float) *PriceUpdate {
+	return &PriceUpdate{price: price}
+}
+
+// RemovePrice returns an update that removes the price, for instance to end a sale.
+func RemovePrice() *PriceUpdate {
+	return &PriceUpdate{remove: true}
+}
+
+func (p PriceUpdate) MarshalJSON() ([]byte, error) {
+	if p.remove {
+		// Woocommerce removes prices that are set to an empty string.
+		return []byte(`""`), nil
+	}
+	return json.Marshal(p.price)
+}
+
+// ProductCommonUpdate contains the common fields of partial updates of products and product variations.
+type ProductCommonUpdate struct {
+	Status       *ProductStatus `json:"status,omitempty"`
+	Description  *string        `json:"description,omitempty"`
+	SKU          *string        `json:"sku,omitempty"`
+	RegularPrice *PriceUpdate   `json:"regular_price,omitempty"`
+	SalePrice    *PriceUpdate   `json:"sale_price,omitempty"`
+	// DateOnSaleFrom and DateOnSaleTo are in the timezone of the store.
+	// Dates that are not valid are sent as null, which removes them from the sale schedule.
+	DateOnSaleFrom    *NullTime          `json:"date_on_sale_from,omitempty"`
+	DateOnSaleFromGMT *NullTime          `json:"date_on_sale_from_gmt,omitempty"`
+	DateOnSaleTo      *NullTime          `json:"date_on_sale_to,omitempty"`
+	DateOnSaleToGMT   *NullTime          `json:"date_on_sale_to_gmt,omitempty"`
+	Virtual           *bool              `json:"virtual,omitempty"`
+	Downloadable      *bool              `json:"downloadable,omitempty"`
+	Downloads         *[]ProductDownload `json:"downloads,omitempty"`
+	DownloadLimit     *int               `json:"download_limit,omitempty"`
+	DownloadExpiry    *int               `json:"download_expiry,omitempty"`
+	TaxStatus         *TaxStatus         `json:"tax_status,omitempty"`
+	TaxClass          *string            `json:"tax_class,omitempty"`
+	StockQuantity     *int               `json:"stock_quantity,omitempty"`
+	StockStatus       *StockStatus       `json:"stock_status,omitempty"`
+	Backorders        *Backorders        `json:"backorders,omitempty"`
+	LowStockAmount    *int               `json:"low_stock_amount,omitempty"`
+	Weight            *string            `json:"weight,omitempty"`
+	Dimensions        *ProductDimensions `json:"dimensions,omitempty"`
+	ShippingClass     *string            `json:"shipping_class,omitempty"`
+	MenuOrder         *int               `json:"menu_order,omitempty"`
+	MetaData          []MetaDataUpdate   `json:"meta_data,omitempty"`
+}
+
+// ProductUpdate is a partial update of a product. Only fields that are not nil are sent,
+// so fields that are not set keep their current values. Empty lists remove all items of the list.
+type ProductUpdate struct {
+	ProductCommonUpdate
+
+	Name              *string                      `json:"name,omitempty"`
+	Slug              *string                      `json:"slug,omitempty"`
+	Type              *ProductType                 `json:"type,omitempty"`
+	Featured          *bool                        `json:"featured,omitempty"`
+	CatalogVisibility *CatalogVisibility           `json:"catalog_visibility,omitempty"`
+	ShortDescription  *string                      `json:"short_description,omitempty"`
+	ExternalURL       *string                      `json:"external_url,omitempty"`
+	ButtonText        *string                      `json:"button_text,omitempty"`
+	ManageStock       *bool                        `json:"manage_stock,omitempty"`
+	SoldIndividually  *bool                        `json:"sold_individually,omitempty"`
+	ReviewsAllowed    *bool                        `json:"reviews_allowed,omitempty"`
+	UpsellIDs         *[]int                       `json:"upsell_ids,omitempty"`
+	CrossSellIDs      *[]int                       `json:"cross_sell_ids,omitempty"`
+	ParentID          *int                         `json:"parent_id,omitempty"`
+	PurchaseNote      *string                      `json:"purchase_note,omitempty"`
+	Categories        *[]ProductTermRef            `json:"categories,omitempty"`
+	Tags              *[]ProductTermRef            `json:"tags,omitempty"`
+	Images            *[]ProductImage              `json:"images,omitempty"`
+	Attributes        *[]ProductAttribute          `json:"attributes,omitempty"`
+	DefaultAttributes *[]ProductVariationAttribute `json:"default_attributes,omitempty"`
+	GroupedProducts   *[]int                       `json:"grouped_products,omitempty"`
+}
+
+// ProductVariationUpdate is a partial update of a product variation. Only fields that are not nil are sent,
+// so fields that are not set keep their current values.
+type ProductVariationUpdate struct {
+	ProductCommonUpdate
+
+	ManageStock *ManageStock                 `json:"manage_stock,omitempty"`
+	Image       *ProductImage                `json:"image,omitempty"`
+	Attributes  *[]ProductVariationAttribute `json:"attributes,omitempty"`
+}
@@ -0,0 +1,41 @@
+package woocommerce
+
+// ReviewStatus is the moderation status of a product review.
+type ReviewStatus string
+
+const (
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusHold     ReviewStatus = "hold"
+	ReviewStatusSpam     ReviewStatus = "spam"
+	ReviewStatusTrash    ReviewStatus = "trash"
+	// ReviewStatusUnspam and ReviewStatusUntrash restore the status that a review had before it was marked
+	// as spam or moved to trash. They can only be sent to woocommerce.
+	ReviewStatusUnspam  ReviewStatus = "unspam"
+	ReviewStatusUntrash ReviewStatus = "untrash"
+	// ReviewStatusAll lists approved reviews and reviews on hold. It can only be used to filter reviews.
+	ReviewStatusAll ReviewStatus = "all"
+)
+
+// ProductReview is a review of a product.
+//
+// Fields with zero values are omitted when the review is created or updated,
+// so only fields that are set are changed.
+type ProductReview struct {
+	ID               int          `json:"id,omitempty"`
+	DateCreated      string       `json:"date_created,omitempty"`
+	DateCreatedGMT   string       `json:"date_created_gmt,omitempty"`
+	ProductID        int          `json:"product_id,omitempty"`
+	ProductName      string       `json:"product_name,omitempty"`
+	ProductPermalink string       `json:"product_permalink,omitempty"`
+	Status           ReviewStatus `json:"status,omitempty"`
+	Reviewer         string       `json:"reviewer,omitempty"`
+	ReviewerEmail    string       `json:"reviewer_email,omitempty"`
+	// Review is the content of the review, which may contain HTML.
+	Review string `json:"review,omitempty"`
+	// Rating is the rating of the product, from 1 to 5. It is 0 if the reviewer did not rate the product.
+	Rating int `json:"rating,omitempty"`
+	// Verified means that the reviewer bought the product.
+	Verified bool `json:"verified,omitempty"`
+	// ReviewerAvatarURLs maps sizes of avatars in pixels to their URLs.
+	ReviewerAvatarURLs map[string]string `json:"reviewer_avatar_urls,omitempty"`
+}
@@ -0,0 +1,79 @@
+package woocommerce
+
+// CategoryDisplay determines what is shown on the archive page of a category.
+type CategoryDisplay string
+
+const (
+	CategoryDisplayDefault       CategoryDisplay = "default"
+	CategoryDisplayProducts      CategoryDisplay = "products"
+	CategoryDisplaySubcategories CategoryDisplay = "subcategories"
+	CategoryDisplayBoth          CategoryDisplay = "both"
+)
+
+// ProductCategory is a category of products. Categories are hierarchical.
+//
+// Fields with zero values are omitted when the category is created.
+// Categories are updated with ProductCategoryUpdate.
+type ProductCategory struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+	// Parent is the ID of the parent category. It is 0 for top level categories.
+	Parent      int             `json:"parent,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Display     CategoryDisplay `json:"display,omitempty"`
+	Image       *ProductImage   `json:"image,omitempty"`
+	// MenuOrder is the position of the category among its siblings.
+	MenuOrder int `json:"menu_order,omitempty"`
+	// Count is the number of products in the category.
+	Count int `json:"count,omitempty"`
+}
+
+// ProductCategoryUpdate is a partial update of a product category. Only fields that are not nil are sent,
+// so fields that are not set keep their current values.
+type ProductCategoryUpdate struct {
+	Name *string `json:"name,omitempty"`
+	Slug *string `json:"slug,omitempty"`
+	// Parent is the ID of the parent category. Set it to 0 to move the category to the top level.
+	Parent      *int             `json:"parent,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Display     *CategoryDisplay `json:"display,omitempty"`
+	Image       *ProductImage    `json:"image,omitempty"`
+	MenuOrder   *int             `json:"menu_order,omitempty"`
+}
+
+// ProductTag is a tag of products.
+type ProductTag struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Count is the number of products with the tag.
+	Count int `json:"count,omitempty"`
+}
+
+// ProductTagUpdate is a partial update of a product tag. Only fields that are not nil are sent,
+// so fields that are not set keep their current values.
+type ProductTagUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ShippingClass is a shipping class of products, which shipping methods use to compute shipping costs.
+type ShippingClass struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Count is the number of products in the shipping class.
+	Count int `json:"count,omitempty"`
+}
+
+// ShippingClassUpdate is a partial update of a shipping class. Only fields that are not nil are sent,
+// so fields that are not set keep their current values.
+type ShippingClassUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,79 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestProductVariation_ManageStock(t *testing.T) {
+	tests := []struct {
+		json     string
+		expected ManageStock
+	}{
+		{`{"manage_stock":true}`, ManageStockEnabled},
+		{`{"manage_stock":false}`, ManageStockDisabled},
+		{`{"manage_stock":"parent"}`, ManageStockParent},
+	}
+	for _, tt := range tests {
+		var variation ProductVariation
+		if err := json.Unmarshal([]byte(tt.json), &variation); err != nil {
+			t.Fatal(err)
+		}
+		if variation.ManageStock != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.json, tt.expected, variation.ManageStock)
+		}
+
+		data, err := json.Marshal(ProductVariation{ManageStock: tt.expected})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.expected == ManageStockParent && !strings.Contains(string(data), `"manage_stock":false`) {
+			t.Errorf("expected parent to be sent as false, got %s", data)
+		}
+	}
+}
+
+func TestProduct_MarshalOmitsUnset(t *testing.T) {
+	// Prices that are not valid are sent as null, which woocommerce ignores.
+	data, err := json.Marshal(Product{Name: "Cap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"price":null,"regular_price":null,"sale_price":null,"name":"Cap"}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	data, err = json.Marshal(Product{ProductCommon: ProductCommon{RegularPrice: NullFloat{Float: 0, Valid: true}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"regular_price":"0"`) {
+		t.Errorf("expected zero price to be sent, got %s", data)
+	}
+}
+
+func TestProductUpdate_Marshal(t *testing.T) {
+	update := ProductUpdate{Featured: Ptr(false), UpsellIDs: Ptr([]int{})}
+	update.SalePrice = RemovePrice()
+	update.RegularPrice = SetPrice(0)
+	update.MenuOrder = Ptr(0)
+	update.DateOnSaleTo = &NullTime{}
+
+	data, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"regular_price":"0","sale_price":"","date_on_sale_to":null,"menu_order":0,"featured":false,"upsell_ids":[]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	data, err = json.Marshal(ProductUpdate{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{}` {
+		t.Errorf("expected an empty update, got %s", data)
+	}
+}
@@ -0,0 +1,66 @@
+package woocommerce
+
+// RefundLineItem is a line of the order that was refunded.
+// Quantities and totals of refunded lines are negative.
+type RefundLineItem struct {
+	OrderItem
+
+	// RefundTotal is the amount that was refunded for the line, without taxes.
+	RefundTotal Float `json:"refund_total"`
+}
+
+// Refund is the order refund object that the API returns.
+type Refund struct {
+	ID int `json:"id"`
+	// ParentID is the ID of the refunded order.
+	ParentID       int  `json:"parent_id"`
+	DateCreated    Time `json:"date_created"`
+	DateCreatedGMT Time `json:"date_created_gmt"`
+	// Amount is the total refund amount. It is positive.
+	Amount Float  `json:"amount"`
+	Reason string `json:"reason"`
+	// RefundedBy is the ID of the user that created the refund.
+	RefundedBy int `json:"refunded_by"`
+	// RefundedPayment is true if the payment was refunded through the payment gateway.
+	RefundedPayment bool             `json:"refunded_payment"`
+	MetaData        []MetaData       `json:"meta_data"`
+	LineItems       []RefundLineItem `json:"line_items"`
+	ShippingLines   []OrderShipping  `json:"shipping_lines"`
+	TaxLines        []OrderTax       `json:"tax_lines"`
+	FeeLines        []OrderFee       `json:"fee_lines"`
+}
+
+// RefundTaxCreate is the refunded amount of a tax of the refunded line.
+type RefundTaxCreate struct {
+	// ID is the ID of the tax rate.
+	ID          int   `json:"id"`
+	RefundTotal Float `json:"refund_total"`
+}
+
+// RefundLineItemCreate is a line of the order that is being refunded.
+// Line items, shipping lines and fee lines of the order can be refunded.
+type RefundLineItemCreate struct {
+	// ID is the ID of the line of the order.
+	ID int `json:"id"`
+	// Quantity is the number of refunded items. It is used for restocking.
+	Quantity int `json:"quantity,omitempty"`
+	// RefundTotal is the refunded amount of the line, without taxes.
+	RefundTotal Float `json:"refund_total,omitempty"`
+	// RefundTax holds refunded amounts of taxes of the line.
+	RefundTax []RefundTaxCreate `json:"refund_tax,omitempty"`
+}
+
+// RefundCreate is used for creating an order refund.
+type RefundCreate struct {
+	// Amount is the total refund amount. If it is zero, woocommerce computes it from the refunded lines.
+	Amount     Float                  `json:"amount,omitempty"`
+	Reason     string                 `json:"reason,omitempty"`
+	RefundedBy int                    `json:"refunded_by,omitempty"`
+	MetaData   []MetaDataUpdate       `json:"meta_data,omitempty"`
+	LineItems  []RefundLineItemCreate `json:"line_items,omitempty"`
+	// APIRefund triggers the refund of the payment through the payment gateway of the order.
+	// If it is false, the refund is only recorded and the money has to be returned manually.
+	APIRefund bool `json:"api_refund"`
+	// RestockItems increases stock of refunded products by the refunded quantities.
+	RestockItems bool `json:"api_restock"`
+}
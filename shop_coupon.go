@@ -0,0 +1,79 @@
+package woocommerce
+
+// ShopCoupon is the coupon object of the REST API.
+// It is different from the Coupon type, which is a coupon applied to the cart of the Store API.
+//
+// Fields with zero values are omitted when the coupon is created.
+// Coupons are updated with ShopCouponUpdate, which can also unset fields.
+type ShopCoupon struct {
+	ID              int    `json:"id,omitempty"`
+	Code            string `json:"code,omitempty"`
+	DateCreated     string `json:"date_created,omitempty"`
+	DateCreatedGMT  string `json:"date_created_gmt,omitempty"`
+	DateModified    string `json:"date_modified,omitempty"`
+	DateModifiedGMT string `json:"date_modified_gmt,omitempty"`
+	// Amount is the discount amount. It is a percentage for percent coupons and a fixed amount otherwise.
+	Amount       Float      `json:"amount,omitempty"`
+	DiscountType CouponType `json:"discount_type,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	// DateExpires is the date when the coupon expires, in the timezone of the store. It is nil if the coupon never expires.
+	DateExpires    *Time `json:"date_expires,omitempty"`
+	DateExpiresGMT *Time `json:"date_expires_gmt,omitempty"`
+	// UsageCount is the number of times the coupon has been used.
+	UsageCount int `json:"usage_count,omitempty"`
+	// IndividualUse means that the coupon can not be used together with other coupons.
+	IndividualUse bool `json:"individual_use,omitempty"`
+	// ProductIDs limits the coupon to products with given IDs.
+	ProductIDs         []int `json:"product_ids,omitempty"`
+	ExcludedProductIDs []int `json:"excluded_product_ids,omitempty"`
+	// UsageLimit is the number of times the coupon can be used in total. It is nil if the usage is not limited.
+	UsageLimit *int `json:"usage_limit,omitempty"`
+	// UsageLimitPerUser is the number of times the coupon can be used by a single customer.
+	// It is nil if the usage is not limited.
+	UsageLimitPerUser *int `json:"usage_limit_per_user,omitempty"`
+	// LimitUsageToXItems is the maximum number of items in the cart that the coupon applies to.
+	// It is nil if the number of items is not limited.
+	LimitUsageToXItems *int `json:"limit_usage_to_x_items,omitempty"`
+	// FreeShipping enables free shipping methods for orders with the coupon.
+	FreeShipping bool `json:"free_shipping,omitempty"`
+	// ProductCategories limits the coupon to products in categories with given IDs.
+	ProductCategories         []int `json:"product_categories,omitempty"`
+	ExcludedProductCategories []int `json:"excluded_product_categories,omitempty"`
+	ExcludeSaleItems          bool  `json:"exclude_sale_items,omitempty"`
+	// MinimumAmount is the minimum order subtotal required for the coupon.
+	MinimumAmount Float `json:"minimum_amount,omitempty"`
+	// MaximumAmount is the maximum order subtotal allowed for the coupon.
+	MaximumAmount Float `json:"maximum_amount,omitempty"`
+	// EmailRestrictions limits the coupon to customers with given billing emails. Wildcards, such as *@example.com, are supported.
+	EmailRestrictions []string `json:"email_restrictions,omitempty"`
+	// UsedBy holds IDs or emails of customers that used the coupon.
+	UsedBy   []string   `json:"used_by,omitempty"`
+	MetaData []MetaData `json:"meta_data,omitempty"`
+}
+
+// ShopCouponUpdate is a partial update of a coupon. Only fields that are not nil are sent,
+// so fields that are not set keep their current values. Empty lists remove restrictions.
+type ShopCouponUpdate struct {
+	Code         *string     `json:"code,omitempty"`
+	Amount       *Float      `json:"amount,omitempty"`
+	DiscountType *CouponType `json:"discount_type,omitempty"`
+	Description  *string     `json:"description,omitempty"`
+	// DateExpires and DateExpiresGMT remove the expiry date when they are set to a NullTime that is not valid.
+	DateExpires        *NullTime `json:"date_expires,omitempty"`
+	DateExpiresGMT     *NullTime `json:"date_expires_gmt,omitempty"`
+	IndividualUse      *bool     `json:"individual_use,omitempty"`
+	ProductIDs         *[]int    `json:"product_ids,omitempty"`
+	ExcludedProductIDs *[]int    `json:"excluded_product_ids,omitempty"`
+	// UsageLimit, UsageLimitPerUser and LimitUsageToXItems remove the limit when they are set to 0.
+	UsageLimit                *int             `json:"usage_limit,omitempty"`
+	UsageLimitPerUser         *int             `json:"usage_limit_per_user,omitempty"`
+	LimitUsageToXItems        *int             `json:"limit_usage_to_x_items,omitempty"`
+	FreeShipping              *bool            `json:"free_shipping,omitempty"`
+	ProductCategories         *[]int           `json:"product_categories,omitempty"`
+	ExcludedProductCategories *[]int           `json:"excluded_product_categories,omitempty"`
+	ExcludeSaleItems          *bool            `json:"exclude_sale_items,omitempty"`
+	MinimumAmount             *Float           `json:"minimum_amount,omitempty"`
+	MaximumAmount             *Float           `json:"maximum_amount,omitempty"`
+	EmailRestrictions         *[]string        `json:"email_restrictions,omitempty"`
+	MetaData                  []MetaDataUpdate `json:"meta_data,omitempty"`
+}
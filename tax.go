@@ -1,8 +1,49 @@
 package woocommerce
 
-// Tax represents a tax object.
+import "encoding/json"
+
+// Tax represents a tax rate.
+//
+// Empty location fields match all locations. Tax rates are sent as a whole, so all fields
+// are set when a rate is created or updated. Note that woocommerce would apply a new rate to shipping by default,
+// while Shipping has to be set explicitly here.
 type Tax struct {
 	ID      int    `json:"id"`
 	Country string `json:"country"`
-	Rate    Float  `json:"rate"`
+	State   string `json:"state"`
+	// Postcodes can contain wildcards, such as 1*, and ranges, such as 1000...1999.
+	Postcodes []string `json:"postcodes"`
+	Cities    []string `json:"cities"`
+	// Rate is the rate in percent, for instance 22 for 22%.
+	Rate Float  `json:"rate"`
+	Name string `json:"name"`
+	// Priority groups rates. Only the first matching rate of each priority is applied.
+	Priority int `json:"priority"`
+	// Compound rates are applied on top of other taxes.
+	Compound bool `json:"compound"`
+	// Shipping is true if the rate is applied to shipping as well.
+	Shipping bool `json:"shipping"`
+	// Order is the order in which the rate is shown and matched within its priority.
+	Order int `json:"order"`
+	// Class is the slug of the tax class. Woocommerce uses standard for the standard rates.
+	Class string `json:"class"`
+}
+
+func (t Tax) MarshalJSON() ([]byte, error) {
+	// Woocommerce rejects null lists, while empty lists remove all postcodes or cities.
+	type tax Tax
+	v := tax(t)
+	if v.Postcodes == nil {
+		v.Postcodes = []string{}
+	}
+	if v.Cities == nil {
+		v.Cities = []string{}
+	}
+	return json.Marshal(v)
+}
+
+// TaxClass is a class of tax rates, for instance reduced rates.
+type TaxClass struct {
+	Slug string `json:"slug,omitempty"`
+	Name string `json:"name"`
 }
@@ -0,0 +1,90 @@
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+)
+
+const (
+	pathClasses   = "/taxes/classes"
+	pathClassEdit = "/taxes/classes/%s"
+)
+
+// ClassStandard is the slug of the standard tax class.
+const ClassStandard = "standard"
+
+// ClassClient is the API client used for working with tax classes.
+// It should not be initialized directly. Use Client.Classes instead.
+type ClassClient struct {
+	backend woocommerce.Backend
+}
+
+// List returns all tax classes, including the standard class. Woocommerce does not paginate tax classes.
+func (c ClassClient) List() ([]*woocommerce.TaxClass, error) {
+	return c.ListContext(context.Background())
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c ClassClient) ListContext(ctx context.Context) ([]*woocommerce.TaxClass, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathClasses, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var classes []*woocommerce.TaxClass
+	err = json.NewDecoder(resp.Body).Decode(&classes)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal tax classes json: %w", err)
+	}
+
+	return classes, nil
+}
+
+// Create creates a new tax class with the given name. Woocommerce generates the slug from the name.
+func (c ClassClient) Create(name string) (*woocommerce.TaxClass, error) {
+	return c.CreateContext(context.Background(), name)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c ClassClient) CreateContext(ctx context.Context, name string) (*woocommerce.TaxClass, error) {
+	return c.request(ctx, http.MethodPost, pathClasses, &woocommerce.TaxClass{Name: name}, nil)
+}
+
+// Delete permanently deletes the tax class with a given slug and returns the deleted class.
+// Woocommerce deletes tax rates of the class as well. The standard class can not be deleted.
+func (c ClassClient) Delete(slug string) (*woocommerce.TaxClass, error) {
+	return c.DeleteContext(context.Background(), slug)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c ClassClient) DeleteContext(ctx context.Context, slug string) (*woocommerce.TaxClass, error) {
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf(pathClassEdit, url.PathEscape(slug)), nil, parameters)
+}
+
+func (c ClassClient) request(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*woocommerce.TaxClass, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var class woocommerce.TaxClass
+	err = json.NewDecoder(resp.Body).Decode(&class)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal tax class json: %w", err)
+	}
+
+	return &class, nil
+}
@@ -1,6 +1,7 @@
 package tax
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/zerodays/woocommerce-go"
@@ -8,27 +9,40 @@ import (
 	"net/http"
 )
 
-const pathList = "/taxes"
+const (
+	pathList  = "/taxes"
+	pathEdit  = "/taxes/%d"
+	pathBatch = "/taxes/batch"
+)
 
 // Client is the API client used for working with taxes.
 // It should not be initialized directly. Use client.API instead.
 type Client struct {
-	backend *backend.Backend
+	backend woocommerce.Backend
+
+	// Classes is the client used for working with tax classes.
+	Classes *ClassClient
 }
 
 // New creates a new client for taxes.
 // It should not be called directly.
 // Instead, client.API should be used.
-func New(backend *backend.Backend) *Client {
+func New(backend woocommerce.Backend) *Client {
 	return &Client{
 		backend: backend,
+		Classes: &ClassClient{backend: backend},
 	}
 }
 
 // List lists taxes with given parameters.
 func (c Client) List(parameters woocommerce.Parameters) ([]*woocommerce.Tax, error) {
+	return c.ListContext(context.Background(), parameters)
+}
+
+// ListContext is the same as List, but it uses the given context for the request.
+func (c Client) ListContext(ctx context.Context, parameters woocommerce.Parameters) ([]*woocommerce.Tax, error) {
 	// Execute authenticated request.
-	resp, err := c.backend.AuthenticatedRequest(backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, http.MethodGet, pathList, nil, parameters, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -43,3 +57,82 @@ func (c Client) List(parameters woocommerce.Parameters) ([]*woocommerce.Tax, err
 
 	return taxes, nil
 }
+
+// Pager returns a pager that walks all pages of taxes with given parameters.
+// Page and per_page values of the parameters are set by the pager.
+func (c Client) Pager(parameters woocommerce.Parameters, options ...woocommerce.PagerOption) *woocommerce.Pager[*woocommerce.Tax] {
+	return woocommerce.NewPager(parameters, func(ctx context.Context, parameters woocommerce.Parameters) (*woocommerce.Page[*woocommerce.Tax], error) {
+		return backend.ListPage[*woocommerce.Tax](ctx, c.backend, pathList, parameters)
+	}, options...)
+}
+
+// Retrieve retrieves a single tax rate by its ID.
+func (c Client) Retrieve(taxID int) (*woocommerce.Tax, error) {
+	return c.RetrieveContext(context.Background(), taxID)
+}
+
+// RetrieveContext is the same as Retrieve, but it uses the given context for the request.
+func (c Client) RetrieveContext(ctx context.Context, taxID int) (*woocommerce.Tax, error) {
+	return c.request(ctx, http.MethodGet, fmt.Sprintf(pathEdit, taxID), nil, nil)
+}
+
+// Create creates a new tax rate.
+func (c Client) Create(tax *woocommerce.Tax) (*woocommerce.Tax, error) {
+	return c.CreateContext(context.Background(), tax)
+}
+
+// CreateContext is the same as Create, but it uses the given context for the request.
+func (c Client) CreateContext(ctx context.Context, tax *woocommerce.Tax) (*woocommerce.Tax, error) {
+	return c.request(ctx, http.MethodPost, pathList, tax, nil)
+}
+
+// Update updates the tax rate with a given ID. All fields of the rate are replaced.
+func (c Client) Update(taxID int, tax *woocommerce.Tax) (*woocommerce.Tax, error) {
+	return c.UpdateContext(context.Background(), taxID, tax)
+}
+
+// UpdateContext is the same as Update, but it uses the given context for the request.
+func (c Client) UpdateContext(ctx context.Context, taxID int, tax *woocommerce.Tax) (*woocommerce.Tax, error) {
+	return c.request(ctx, http.MethodPut, fmt.Sprintf(pathEdit, taxID), tax, nil)
+}
+
+// Delete permanently deletes the tax rate with a given ID and returns the deleted rate.
+// Woocommerce does not support moving tax rates to trash.
+func (c Client) Delete(taxID int) (*woocommerce.Tax, error) {
+	return c.DeleteContext(context.Background(), taxID)
+}
+
+// DeleteContext is the same as Delete, but it uses the given context for the request.
+func (c Client) DeleteContext(ctx context.Context, taxID int) (*woocommerce.Tax, error) {
+	parameters := woocommerce.BaseParameters{"force": {"true"}}
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf(pathEdit, taxID), nil, parameters)
+}
+
+func (c Client) request(ctx context.Context, method, path string, body interface{}, parameters woocommerce.Parameters) (*woocommerce.Tax, error) {
+	// Execute authenticated request.
+	resp, err := c.backend.AuthenticatedRequestContext(ctx, backend.APITypeRest, method, path, body, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Unmarshal JSON.
+	var tax woocommerce.Tax
+	err = json.NewDecoder(resp.Body).Decode(&tax)
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not unmarshal tax json: %w", err)
+	}
+
+	return &tax, nil
+}
+
+// Batch creates, updates and deletes multiple taxes. Batches larger than woocommerce.MaxBatchSize
+// are split into multiple requests. Taxes that could not be processed are reported by the Err method of the response.
+func (c Client) Batch(request woocommerce.BatchRequest[woocommerce.Tax, woocommerce.Tax]) (*woocommerce.BatchResponse[*woocommerce.Tax], error) {
+	return c.BatchContext(context.Background(), request)
+}
+
+// BatchContext is the same as Batch, but it uses the given context for the request.
+func (c Client) BatchContext(ctx context.Context, request woocommerce.BatchRequest[woocommerce.Tax, woocommerce.Tax]) (*woocommerce.BatchResponse[*woocommerce.Tax], error) {
+	return backend.Batch[woocommerce.Tax, woocommerce.Tax, *woocommerce.Tax](ctx, c.backend, pathBatch, request)
+}
@@ -0,0 +1,149 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestClient_Batch(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	existingID, err := srv.Add(wctest.ResourceTaxes, woocommerce.Tax{Country: "SI", Rate: 22})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := woocommerce.BatchRequest[woocommerce.Tax, woocommerce.Tax]{
+		Update: []woocommerce.BatchUpdate[woocommerce.Tax]{{ID: existingID, Update: woocommerce.Tax{Country: "SI", Rate: 9.5}}},
+		Delete: []int{123456},
+	}
+	for i := 0; i < 250; i++ {
+		request.Create = append(request.Create, woocommerce.Tax{Country: "AT", Rate: 20})
+	}
+
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+	response, err := client.Batch(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Create) != 250 || len(response.Update) != 1 || len(response.Delete) != 1 {
+		t.Fatalf("unexpected response sizes: %d/%d/%d", len(response.Create), len(response.Update), len(response.Delete))
+	}
+	if response.Update[0].Object.Rate != 9.5 {
+		t.Errorf("expected updated rate 9.5, got %v", response.Update[0].Object.Rate)
+	}
+
+	var batchErr *woocommerce.BatchError
+	if err := response.Err(); !errors.As(err, &batchErr) || len(batchErr.Items) != 1 || batchErr.Items[0].Operation != woocommerce.BatchOperationDelete {
+		t.Errorf("expected a single delete error, got %v", err)
+	}
+
+	taxes, err := client.Pager(nil).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(taxes) != 251 {
+		t.Errorf("expected 251 taxes, got %d", len(taxes))
+	}
+}
+
+func TestClient_CRUD(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	created, err := client.Create(&woocommerce.Tax{
+		Country:   "AT",
+		Postcodes: []string{"6691", "6991...6993"},
+		Rate:      19,
+		Name:      "USt",
+		Priority:  1,
+		Shipping:  true,
+		Class:     "reduced-rate",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.Rate != 19 || len(created.Postcodes) != 2 || created.Class != "reduced-rate" || created.Cities == nil {
+		t.Errorf("unexpected tax %+v", created)
+	}
+
+	update := *created
+	update.Postcodes = nil
+	update.Rate = 13
+	updated, err := client.Update(created.ID, &update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Rate != 13 || len(updated.Postcodes) != 0 || !updated.Shipping {
+		t.Errorf("unexpected updated tax %+v", updated)
+	}
+
+	retrieved, err := client.Retrieve(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.Name != "USt" || retrieved.Rate != 13 {
+		t.Errorf("unexpected retrieved tax %+v", retrieved)
+	}
+
+	if _, err := client.Create(&woocommerce.Tax{Country: "AT", Rate: 10, Class: "luxury"}); err == nil {
+		t.Error("expected an error for an unknown tax class")
+	}
+
+	if _, err := client.Delete(created.ID); err != nil {
+		t.Fatal(err)
+	}
+	var wcErr *woocommerce.Error
+	if _, err := client.Retrieve(created.ID); !errors.As(err, &wcErr) || wcErr.StatusCode != 404 {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestClassClient(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	class, err := client.Classes.Create("Super reduced rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if class.Slug != "super-reduced-rate" {
+		t.Errorf("expected slug super-reduced-rate, got %s", class.Slug)
+	}
+	if _, err := client.Classes.Create("Super reduced rate"); err == nil {
+		t.Error("expected an error for an existing class")
+	}
+
+	classes, err := client.Classes.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 4 || classes[0].Slug != ClassStandard {
+		t.Errorf("unexpected classes %+v", classes)
+	}
+
+	if _, err := client.Create(&woocommerce.Tax{Country: "LU", Rate: 3, Class: class.Slug}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Classes.Delete(class.Slug); err != nil {
+		t.Fatal(err)
+	}
+	taxes, err := client.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(taxes) != 0 {
+		t.Errorf("expected rates of the deleted class to be deleted, got %d", len(taxes))
+	}
+
+	if _, err := client.Classes.Delete(ClassStandard); err == nil {
+		t.Error("expected an error when deleting the standard class")
+	}
+}
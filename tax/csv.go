@@ -0,0 +1,188 @@
+package tax
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// CSVHeader holds the columns of tax rate CSV files that woocommerce imports and exports.
+var CSVHeader = []string{"Country code", "State code", "Postcode / ZIP", "City", "Rate %", "Tax name", "Priority", "Compound", "Shipping", "Tax class"}
+
+// csvWildcard is the value woocommerce exports for empty location fields.
+const csvWildcard = "*"
+
+// ReadCSV reads tax rates from a CSV file in the format of the woocommerce tax rate importer.
+// The first row is the header. Postcodes and cities are separated by semicolons and * matches all locations.
+//
+// Files do not contain IDs of rates. As in woocommerce, Order of a rate is its position in the file
+// and rates without a class belong to the standard class.
+func ReadCSV(r io.Reader) ([]*woocommerce.Tax, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("[woocommerce-go]: tax rate csv is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[woocommerce-go]: could not read tax rate csv: %w", err)
+	}
+	if len(header) != len(CSVHeader) {
+		return nil, fmt.Errorf("[woocommerce-go]: tax rate csv header has %d columns, expected %d", len(header), len(CSVHeader))
+	}
+
+	var rates []*woocommerce.Tax
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("[woocommerce-go]: could not read tax rate csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rate, err := parseCSVRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("[woocommerce-go]: invalid tax rate on line %d: %w", line, err)
+		}
+		rate.Order = len(rates)
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func parseCSVRecord(record []string) (*woocommerce.Tax, error) {
+	if len(record) != len(CSVHeader) {
+		return nil, fmt.Errorf("expected %d columns, got %d", len(CSVHeader), len(record))
+	}
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	rate := &woocommerce.Tax{
+		Country:   csvLocation(record[0]),
+		State:     csvLocation(record[1]),
+		Postcodes: csvLocations(record[2]),
+		Cities:    csvLocations(record[3]),
+		Name:      record[5],
+		Priority:  1,
+		Class:     ClassStandard,
+	}
+
+	if record[4] != "" {
+		value, err := strconv.ParseFloat(record[4], 64)
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("invalid rate %q", record[4])
+		}
+		rate.Rate = woocommerce.Float(value)
+	}
+	if record[6] != "" {
+		priority, err := strconv.Atoi(record[6])
+		if err != nil || priority < 0 {
+			return nil, fmt.Errorf("invalid priority %q", record[6])
+		}
+		rate.Priority = priority
+	}
+
+	var err error
+	if rate.Compound, err = csvFlag(record[7]); err != nil {
+		return nil, fmt.Errorf("invalid compound value %q", record[7])
+	}
+	if rate.Shipping, err = csvFlag(record[8]); err != nil {
+		return nil, fmt.Errorf("invalid shipping value %q", record[8])
+	}
+
+	if class := strings.ToLower(record[9]); class != "" {
+		rate.Class = class
+	}
+	return rate, nil
+}
+
+// csvLocation converts a country or state code, where * matches all locations.
+func csvLocation(value string) string {
+	if value == csvWildcard {
+		return ""
+	}
+	return strings.ToUpper(value)
+}
+
+// csvLocations splits semicolon separated postcodes or cities.
+func csvLocations(value string) []string {
+	var locations []string
+	for _, location := range strings.Split(value, ";") {
+		location = strings.ToUpper(strings.TrimSpace(location))
+		if location != "" && location != csvWildcard {
+			locations = append(locations, location)
+		}
+	}
+	return locations
+}
+
+// csvFlag parses compound and shipping flags, which woocommerce exports as 1 and 0.
+func csvFlag(value string) (bool, error) {
+	switch value {
+	case "1":
+		return true, nil
+	case "0", "":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid flag %q", value)
+}
+
+// WriteCSV writes tax rates in the format of the woocommerce tax rate exporter, which ReadCSV reads.
+// Rates are written in the given order and IDs are not written.
+func WriteCSV(w io.Writer, rates []*woocommerce.Tax) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return fmt.Errorf("[woocommerce-go]: could not write tax rate csv: %w", err)
+	}
+
+	for _, rate := range rates {
+		class := rate.Class
+		if class == ClassStandard {
+			class = ""
+		}
+		record := []string{
+			csvValue(rate.Country),
+			csvValue(rate.State),
+			csvValue(strings.Join(rate.Postcodes, ";")),
+			csvValue(strings.Join(rate.Cities, ";")),
+			strconv.FormatFloat(float64(rate.Rate), 'f', 4, 64),
+			rate.Name,
+			strconv.Itoa(rate.Priority),
+			csvFlagValue(rate.Compound),
+			csvFlagValue(rate.Shipping),
+			class,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("[woocommerce-go]: could not write tax rate csv: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("[woocommerce-go]: could not write tax rate csv: %w", err)
+	}
+	return nil
+}
+
+func csvValue(value string) string {
+	if value == "" {
+		return csvWildcard
+	}
+	return value
+}
+
+func csvFlagValue(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
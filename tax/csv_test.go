@@ -0,0 +1,77 @@
+package tax
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// woocommerceExport is a file as exported by woocommerce, with placeholders for empty fields.
+const woocommerceExport = `Country code,State code,Postcode / ZIP,City,Rate %,Tax name,Priority,Compound,Shipping,Tax class
+"AT","*","*","*","20.0000","USt","1","0","1",""
+"AT","*","6691; 6991...6993","*","19.0000","MwSt","1","0","1",""
+"US","ca","*","Los Angeles;San Francisco","2.2500","County","2","0","0",""
+"CA","QC","*","*","9.9750","QST","2","1","1",""
+"*","*","*","*","10.0000","Reduced","1","0","1","Reduced-Rate"
+`
+
+func TestReadCSV(t *testing.T) {
+	rates, err := ReadCSV(strings.NewReader(woocommerceExport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*woocommerce.Tax{
+		{Country: "AT", Rate: 20, Name: "USt", Priority: 1, Shipping: true, Order: 0, Class: "standard"},
+		{Country: "AT", Postcodes: []string{"6691", "6991...6993"}, Rate: 19, Name: "MwSt", Priority: 1, Shipping: true, Order: 1, Class: "standard"},
+		{Country: "US", State: "CA", Cities: []string{"LOS ANGELES", "SAN FRANCISCO"}, Rate: 2.25, Name: "County", Priority: 2, Order: 2, Class: "standard"},
+		{Country: "CA", State: "QC", Rate: 9.975, Name: "QST", Priority: 2, Compound: true, Shipping: true, Order: 3, Class: "standard"},
+		{Rate: 10, Name: "Reduced", Priority: 1, Shipping: true, Order: 4, Class: "reduced-rate"},
+	}
+	if !reflect.DeepEqual(rates, expected) {
+		for i := range rates {
+			t.Logf("%+v", *rates[i])
+		}
+		t.Fatal("unexpected rates")
+	}
+
+	// Rates round-trip through the writer.
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, rates); err != nil {
+		t.Fatal(err)
+	}
+	written, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(written, rates) {
+		t.Error("rates changed after writing and reading them")
+	}
+}
+
+func TestReadCSV_Errors(t *testing.T) {
+	header := strings.Join(CSVHeader, ",") + "\n"
+	tests := map[string]string{
+		"empty":          "",
+		"short header":   "Country code,State code\n",
+		"short row":      header + "AT,*,*,*,20\n",
+		"invalid rate":   header + "AT,*,*,*,twenty,USt,1,0,1,\n",
+		"negative rate":  header + "AT,*,*,*,-1,USt,1,0,1,\n",
+		"invalid flag":   header + "AT,*,*,*,20,USt,1,yes,1,\n",
+		"invalid prio":   header + "AT,*,*,*,20,USt,first,0,1,\n",
+		"unclosed quote": header + "\"AT,*,*,*,20,USt,1,0,1,\n",
+	}
+	for name, data := range tests {
+		if _, err := ReadCSV(strings.NewReader(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	_, err := ReadCSV(strings.NewReader(header + "AT,*,*,*,20,USt,1,0,1,\nSI,*,*,*,22,DDV,x,0,1,\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("expected an error on line 3, got %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// SyncOption configures Sync.
+type SyncOption func(*syncOptions)
+
+type syncOptions struct {
+	dryRun  bool
+	classes []string
+}
+
+// WithDryRun makes Sync only report the changes, without applying them.
+func WithDryRun() SyncOption {
+	return func(o *syncOptions) {
+		o.dryRun = true
+	}
+}
+
+// WithClasses adds tax classes whose rates are synced, even if none of the given rates belong to them.
+// It can be used to delete all rates of a class.
+func WithClasses(classes ...string) SyncOption {
+	return func(o *syncOptions) {
+		o.classes = append(o.classes, classes...)
+	}
+}
+
+// SyncUpdate is a rate of the store that is updated to the desired rate.
+type SyncUpdate struct {
+	Current *woocommerce.Tax
+	// Desired is the rate that replaces the current rate. Its ID is the ID of the current rate.
+	Desired *woocommerce.Tax
+}
+
+// SyncReport holds changes of a sync.
+type SyncReport struct {
+	Create    []*woocommerce.Tax
+	Update    []SyncUpdate
+	Delete    []*woocommerce.Tax
+	Unchanged []*woocommerce.Tax
+	// DryRun is true if the changes were not applied.
+	DryRun bool
+	// Response is the response of the batch request that applied the changes.
+	// It is nil for dry runs and when there were no changes.
+	Response *woocommerce.BatchResponse[*woocommerce.Tax]
+}
+
+// Changed returns true if the sync creates, updates or deletes any rate.
+func (r *SyncReport) Changed() bool {
+	return len(r.Create) > 0 || len(r.Update) > 0 || len(r.Delete) > 0
+}
+
+// String returns a readable summary of the changes, with a line per created, updated and deleted rate.
+func (r *SyncReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d to create, %d to update, %d to delete, %d unchanged", len(r.Create), len(r.Update), len(r.Delete), len(r.Unchanged))
+	if r.DryRun {
+		b.WriteString(" (dry run)")
+	}
+	for _, rate := range r.Create {
+		fmt.Fprintf(&b, "\n+ %s", describeRate(rate))
+	}
+	for _, update := range r.Update {
+		fmt.Fprintf(&b, "\n~ %d: %s -> %s", update.Current.ID, describeRate(update.Current), describeRate(update.Desired))
+	}
+	for _, rate := range r.Delete {
+		fmt.Fprintf(&b, "\n- %d: %s", rate.ID, describeRate(rate))
+	}
+	return b.String()
+}
+
+func describeRate(rate *woocommerce.Tax) string {
+	flags := ""
+	if rate.Compound {
+		flags += " compound"
+	}
+	if rate.Shipping {
+		flags += " shipping"
+	}
+	return fmt.Sprintf("%s %s%% %q [%s %s %s %s] priority %d order %d%s",
+		classSlug(rate.Class), strconv.FormatFloat(float64(rate.Rate), 'f', 4, 64), rate.Name,
+		csvValue(rate.Country), csvValue(rate.State), csvValue(strings.Join(rate.Postcodes, ";")), csvValue(strings.Join(rate.Cities, ";")),
+		rate.Priority, rate.Order, flags)
+}
+
+// Sync makes tax rates of the store equal to the given rates, for instance rates read with ReadCSV.
+// Only rates of tax classes of the given rates are synced, so a file with rates of a single class
+// leaves other classes intact. WithClasses adds classes to the sync.
+//
+// Rates with the same class, location and priority are updated in place. Other rates of the store are deleted
+// and missing rates are created. All changes are applied with batch requests. Rates that woocommerce
+// could not process are reported by the returned error, which is a *woocommerce.BatchError.
+func (c Client) Sync(rates []*woocommerce.Tax, options ...SyncOption) (*SyncReport, error) {
+	return c.SyncContext(context.Background(), rates, options...)
+}
+
+// SyncContext is the same as Sync, but it uses the given context for the requests.
+func (c Client) SyncContext(ctx context.Context, rates []*woocommerce.Tax, options ...SyncOption) (*SyncReport, error) {
+	opts := syncOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	classes := map[string]bool{}
+	for _, class := range opts.classes {
+		classes[classSlug(class)] = true
+	}
+	for _, rate := range rates {
+		classes[classSlug(rate.Class)] = true
+	}
+
+	all, err := c.Pager(nil, woocommerce.WithPageSize(woocommerce.MaxPageSize)).Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var existing []*woocommerce.Tax
+	for _, rate := range all {
+		if classes[classSlug(rate.Class)] {
+			existing = append(existing, rate)
+		}
+	}
+	sort.SliceStable(existing, func(i, j int) bool {
+		if existing[i].Order != existing[j].Order {
+			return existing[i].Order < existing[j].Order
+		}
+		return existing[i].ID < existing[j].ID
+	})
+
+	// Rates with the same key are paired in order.
+	candidates := map[string][]*woocommerce.Tax{}
+	for _, rate := range existing {
+		key := syncKey(rate)
+		candidates[key] = append(candidates[key], rate)
+	}
+
+	report := &SyncReport{DryRun: opts.dryRun}
+	matched := map[*woocommerce.Tax]bool{}
+	for _, rate := range rates {
+		key := syncKey(rate)
+		if len(candidates[key]) == 0 {
+			report.Create = append(report.Create, rate)
+			continue
+		}
+
+		current := candidates[key][0]
+		candidates[key] = candidates[key][1:]
+		matched[current] = true
+		if sameRate(current, rate) {
+			report.Unchanged = append(report.Unchanged, current)
+			continue
+		}
+		desired := *rate
+		desired.ID = current.ID
+		report.Update = append(report.Update, SyncUpdate{Current: current, Desired: &desired})
+	}
+	for _, rate := range existing {
+		if !matched[rate] {
+			report.Delete = append(report.Delete, rate)
+		}
+	}
+
+	if opts.dryRun || !report.Changed() {
+		return report, nil
+	}
+
+	request := woocommerce.BatchRequest[woocommerce.Tax, woocommerce.Tax]{}
+	for _, rate := range report.Create {
+		request.Create = append(request.Create, *rate)
+	}
+	for _, update := range report.Update {
+		request.Update = append(request.Update, woocommerce.BatchUpdate[woocommerce.Tax]{ID: update.Desired.ID, Update: *update.Desired})
+	}
+	for _, rate := range report.Delete {
+		request.Delete = append(request.Delete, rate.ID)
+	}
+
+	report.Response, err = c.BatchContext(ctx, request)
+	if err != nil {
+		return report, err
+	}
+	return report, report.Response.Err()
+}
+
+// syncKey identifies a rate by its class, location and priority.
+// Postcodes and cities are compared regardless of their order and case.
+func syncKey(rate *woocommerce.Tax) string {
+	return strings.Join([]string{
+		classSlug(rate.Class),
+		strings.ToUpper(rate.Country),
+		strings.ToUpper(rate.State),
+		locationsKey(rate.Postcodes),
+		locationsKey(rate.Cities),
+		strconv.Itoa(rate.Priority),
+	}, "|")
+}
+
+func locationsKey(locations []string) string {
+	normalized := make([]string, len(locations))
+	for i, location := range locations {
+		normalized[i] = strings.ToUpper(strings.TrimSpace(location))
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ";")
+}
+
+// sameRate compares fields of rates with the same key. Rates are compared with the precision
+// of 4 decimals that woocommerce stores.
+func sameRate(a, b *woocommerce.Tax) bool {
+	return strconv.FormatFloat(float64(a.Rate), 'f', 4, 64) == strconv.FormatFloat(float64(b.Rate), 'f', 4, 64) &&
+		a.Name == b.Name && a.Compound == b.Compound && a.Shipping == b.Shipping && a.Order == b.Order
+}
+
+// classSlug returns the slug of the class, with the standard class for an empty class.
+func classSlug(class string) string {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == "" {
+		return ClassStandard
+	}
+	return class
+}
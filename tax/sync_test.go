@@ -0,0 +1,110 @@
+package tax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func TestClient_Sync(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+	client := New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	existing := []woocommerce.Tax{
+		{Country: "AT", Rate: 20, Name: "USt", Priority: 1, Shipping: true, Order: 0},
+		{Country: "AT", Postcodes: []string{"6691"}, Rate: 19, Name: "MwSt", Priority: 1, Shipping: true, Order: 1},
+		{Country: "DE", Rate: 19, Name: "MwSt", Priority: 1, Shipping: true, Order: 2},
+		{Country: "AT", Rate: 10, Name: "USt", Priority: 1, Shipping: true, Class: "reduced-rate"},
+	}
+	ids := make([]int, len(existing))
+	for i, rate := range existing {
+		id, err := srv.Add(wctest.ResourceTaxes, rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+	}
+
+	// The reduced rate is not in the file, so it is kept.
+	rates, err := ReadCSV(strings.NewReader(strings.Join(CSVHeader, ",") + `
+AT,*,*,*,20.0000,USt,1,0,1,
+at,*,6691,*,19.0000,MwSt Kleinwalsertal,1,0,1,
+SI,*,*,*,22.0000,DDV,1,0,1,
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := client.Sync(rates, WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.DryRun || report.Response != nil {
+		t.Error("expected a dry run")
+	}
+	if len(report.Unchanged) != 1 || len(report.Update) != 1 || len(report.Create) != 1 || len(report.Delete) != 1 {
+		t.Fatalf("unexpected report:\n%s", report)
+	}
+	if report.Update[0].Current.ID != ids[1] || report.Update[0].Desired.Name != "MwSt Kleinwalsertal" || report.Delete[0].ID != ids[2] {
+		t.Errorf("unexpected report:\n%s", report)
+	}
+	if !strings.Contains(report.String(), "(dry run)") {
+		t.Errorf("expected the summary to mention the dry run:\n%s", report)
+	}
+	if all, _ := client.List(nil); len(all) != len(existing) {
+		t.Fatalf("dry run changed rates")
+	}
+
+	report, err = client.Sync(rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Response == nil || len(report.Response.Create) != 1 {
+		t.Fatalf("unexpected report:\n%s", report)
+	}
+
+	all, err := client.List(woocommerce.BaseParameters{"per_page": {"100"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]string{}
+	for _, rate := range all {
+		names[rate.Country+"/"+rate.Class+"/"+strings.Join(rate.Postcodes, ";")] = rate.Name
+	}
+	expected := map[string]string{
+		"AT/standard/":     "USt",
+		"AT/standard/6691": "MwSt Kleinwalsertal",
+		"SI/standard/":     "DDV",
+		"AT/reduced-rate/": "USt",
+	}
+	if len(names) != len(expected) {
+		t.Errorf("expected %d rates, got %v", len(expected), names)
+	}
+	for key, name := range expected {
+		if names[key] != name {
+			t.Errorf("expected rate %s named %q, got %q", key, name, names[key])
+		}
+	}
+
+	// Syncing again changes nothing.
+	report, err = client.Sync(rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Changed() || report.Response != nil {
+		t.Errorf("expected no changes:\n%s", report)
+	}
+
+	// Classes can be emptied.
+	report, err = client.Sync(nil, WithClasses("reduced-rate"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Delete) != 1 || report.Delete[0].ID != ids[3] {
+		t.Errorf("expected the reduced rate to be deleted:\n%s", report)
+	}
+}
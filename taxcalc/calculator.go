@@ -0,0 +1,350 @@
+// Package taxcalc calculates taxes locally, using tax rates and classes of a woocommerce store.
+//
+// Rates are matched and taxes are calculated the same way as woocommerce does, so prices with taxes
+// can be shown without a request per product:
+//
+//	calc, err := taxcalc.Load(api.Tax, taxcalc.WithPricesIncludeTax(taxcalc.Location{Country: "SI"}))
+//	if err != nil {
+//		return err
+//	}
+//	result := calc.Calculate(taxcalc.Location{Country: "AT", Postcode: "6691"}, "standard", 19.99)
+//	fmt.Println(result.Gross)
+//
+// Settings of the calculator must match tax settings of the store. Woocommerce filters that change
+// tax calculations are not applied.
+package taxcalc
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/tax"
+)
+
+// DefaultDecimals is the number of decimals of prices that woocommerce uses by default.
+const DefaultDecimals = 2
+
+// ShippingTaxClassInherit is the shipping tax class setting of woocommerce that determines
+// the shipping tax class from tax classes of items in the cart.
+const ShippingTaxClassInherit = "inherit"
+
+// Option configures the calculator. Options correspond to tax settings of the store.
+type Option func(*options)
+
+type options struct {
+	pricesIncludeTax bool
+	base             Location
+	roundAtSubtotal  bool
+	decimals         int
+	shippingClass    string
+}
+
+// WithPricesIncludeTax sets that prices are entered with taxes of the store base location.
+// Prices for other locations are adjusted by removing base taxes and adding taxes of the location,
+// as woocommerce does by default.
+func WithPricesIncludeTax(base Location) Option {
+	return func(o *options) {
+		o.pricesIncludeTax = true
+		o.base = base
+	}
+}
+
+// WithRoundAtSubtotal sets that taxes are rounded at subtotal instead of per rate.
+func WithRoundAtSubtotal() Option {
+	return func(o *options) {
+		o.roundAtSubtotal = true
+	}
+}
+
+// WithDecimals sets the number of decimals of prices. DefaultDecimals is used by default.
+func WithDecimals(decimals int) Option {
+	return func(o *options) {
+		o.decimals = decimals
+	}
+}
+
+// WithShippingTaxClass sets the tax class of shipping. By default, it is ShippingTaxClassInherit.
+func WithShippingTaxClass(class string) Option {
+	return func(o *options) {
+		o.shippingClass = class
+	}
+}
+
+// Calculator calculates taxes with a fixed set of tax rates. It is safe for concurrent use.
+type Calculator struct {
+	rates   []*woocommerce.Tax
+	classes []string
+	options options
+}
+
+// New creates a calculator for the given rates and classes. Classes are used to determine
+// the shipping tax class and should be in the order returned by woocommerce.
+func New(rates []*woocommerce.Tax, classes []*woocommerce.TaxClass, opts ...Option) *Calculator {
+	c := &Calculator{
+		rates:   rates,
+		options: options{decimals: DefaultDecimals, shippingClass: ShippingTaxClassInherit},
+	}
+	for _, class := range classes {
+		c.classes = append(c.classes, normalizeClass(class.Slug))
+	}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+	return c
+}
+
+// Load loads all tax rates and classes of the store and creates a calculator for them.
+func Load(client *tax.Client, opts ...Option) (*Calculator, error) {
+	return LoadContext(context.Background(), client, opts...)
+}
+
+// LoadContext is the same as Load, but it uses the given context for the requests.
+func LoadContext(ctx context.Context, client *tax.Client, opts ...Option) (*Calculator, error) {
+	rates, err := client.Pager(nil, woocommerce.WithPageSize(woocommerce.MaxPageSize)).Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	classes, err := client.Classes.ListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(rates, classes, opts...), nil
+}
+
+// TaxAmount is the tax of a single rate.
+type TaxAmount struct {
+	Rate   *woocommerce.Tax
+	Amount float64
+}
+
+// Result is the result of a tax calculation.
+type Result struct {
+	// Net is the price without taxes.
+	Net float64
+	// Gross is the price with taxes.
+	Gross float64
+	// Total is the sum of taxes.
+	Total float64
+	// Taxes are taxes of applied rates, in the order of the rates.
+	// Amounts are rounded, unless taxes are rounded at subtotal.
+	Taxes []TaxAmount
+}
+
+// Rates returns rates of the tax class that apply to the location, in the order in which woocommerce applies them.
+// Empty class and standard are the standard class.
+func (c *Calculator) Rates(location Location, class string) []*woocommerce.Tax {
+	return matchRates(c.rates, location, class)
+}
+
+// ShippingRates returns rates of the tax class that apply to shipping to the location.
+// Rates that do not apply to shipping still take precedence within their priority, as in woocommerce.
+func (c *Calculator) ShippingRates(location Location, class string) []*woocommerce.Tax {
+	var rates []*woocommerce.Tax
+	for _, rate := range c.Rates(location, class) {
+		if rate.Shipping {
+			rates = append(rates, rate)
+		}
+	}
+	return rates
+}
+
+// Calculate calculates taxes of the price of a product with the tax class for the location.
+// The price includes taxes if the calculator is created with WithPricesIncludeTax.
+// Products that are not taxable should not be passed to the calculator.
+func (c *Calculator) Calculate(location Location, class string, price float64) Result {
+	rates := c.Rates(location, class)
+
+	if !c.options.pricesIncludeTax {
+		taxes := exclusiveTaxes(price, rates)
+		total := c.sumTaxes(taxes)
+		return c.result(price, c.round(price+total), total, rates, taxes)
+	}
+
+	// Prices include taxes of the base location. If the location has the same rates,
+	// the price is kept and the taxes are included in it.
+	baseRates := c.Rates(c.options.base, class)
+	baseTaxes := inclusiveTaxes(price, baseRates)
+	baseTotal := c.sumTaxes(baseTaxes)
+	if sameRates(rates, baseRates) {
+		return c.result(c.round(price-baseTotal), price, baseTotal, rates, baseTaxes)
+	}
+
+	// Otherwise, taxes of the location are added to the price without base taxes.
+	// The net price is calculated from unrounded base taxes, as in wc_get_price_including_tax.
+	net := price - sum(baseTaxes)
+	taxes := exclusiveTaxes(net, rates)
+	total := c.sumTaxes(taxes)
+	return c.result(c.round(price-baseTotal), c.round(price-baseTotal+total), total, rates, taxes)
+}
+
+// CalculateShipping calculates taxes of the shipping cost for the location. Shipping costs never include taxes.
+// Tax classes of taxable items in the cart determine the tax class of shipping
+// if the shipping tax class is ShippingTaxClassInherit. If there are no taxable items, shipping is not taxed.
+func (c *Calculator) CalculateShipping(location Location, cost float64, itemClasses []string) Result {
+	rates := c.shippingRates(location, itemClasses)
+	taxes := exclusiveTaxes(cost, rates)
+	total := c.sumTaxes(taxes)
+	return c.result(cost, c.round(cost+total), total, rates, taxes)
+}
+
+// shippingRates mirrors WC_Tax::get_shipping_tax_rates.
+func (c *Calculator) shippingRates(location Location, itemClasses []string) []*woocommerce.Tax {
+	if c.options.shippingClass != ShippingTaxClassInherit {
+		return c.ShippingRates(location, c.options.shippingClass)
+	}
+	if len(itemClasses) == 0 {
+		return nil
+	}
+
+	classes := map[string]bool{}
+	for _, class := range itemClasses {
+		classes[normalizeClass(class)] = true
+	}
+
+	var rates []*woocommerce.Tax
+	switch {
+	case len(classes) > 1 && !classes[""]:
+		// The first class in the order of the store is used if there is no standard item.
+		for _, class := range c.classes {
+			if classes[class] {
+				rates = c.ShippingRates(location, class)
+				break
+			}
+		}
+	case len(classes) == 1:
+		for class := range classes {
+			rates = c.ShippingRates(location, class)
+		}
+	}
+
+	if len(rates) == 0 {
+		rates = c.ShippingRates(location, "")
+	}
+	return rates
+}
+
+func (c *Calculator) result(net, gross, total float64, rates []*woocommerce.Tax, taxes []float64) Result {
+	result := Result{Net: net, Gross: gross, Total: total}
+	for i, rate := range rates {
+		amount := taxes[i]
+		if !c.options.roundAtSubtotal {
+			amount = c.roundTax(amount)
+		}
+		result.Taxes = append(result.Taxes, TaxAmount{Rate: rate, Amount: amount})
+	}
+	return result
+}
+
+// sumTaxes sums taxes, which are rounded first unless taxes are rounded at subtotal.
+func (c *Calculator) sumTaxes(taxes []float64) float64 {
+	total := 0.0
+	for _, amount := range taxes {
+		if !c.options.roundAtSubtotal {
+			amount = c.roundTax(amount)
+		}
+		total += amount
+	}
+	return total
+}
+
+// round rounds the price, as NumberUtil::round does.
+func (c *Calculator) round(price float64) float64 {
+	return round(price, c.options.decimals, false)
+}
+
+// roundTax rounds the tax, as wc_round_tax_total does. Woocommerce rounds half down
+// when prices include taxes.
+func (c *Calculator) roundTax(amount float64) float64 {
+	return round(amount, c.options.decimals, c.options.pricesIncludeTax)
+}
+
+// exclusiveTaxes mirrors WC_Tax::calc_exclusive_tax. Compound rates are applied to the price
+// with all regular taxes, but not to each other.
+func exclusiveTaxes(price float64, rates []*woocommerce.Tax) []float64 {
+	taxes := make([]float64, len(rates))
+	for i, rate := range rates {
+		if !rate.Compound {
+			taxes[i] = price * float64(rate.Rate) / 100
+		}
+	}
+
+	regular := sum(taxes)
+	for i, rate := range rates {
+		if rate.Compound {
+			taxes[i] = (price + regular) * float64(rate.Rate) / 100
+		}
+	}
+	return taxes
+}
+
+// inclusiveTaxes mirrors WC_Tax::calc_inclusive_tax. Compound taxes are removed from the price first,
+// starting with the last one, and regular taxes are split from the remaining price.
+func inclusiveTaxes(price float64, rates []*woocommerce.Tax) []float64 {
+	taxes := make([]float64, len(rates))
+
+	nonCompound := price
+	for i := len(rates) - 1; i >= 0; i-- {
+		if rates[i].Compound {
+			amount := nonCompound - nonCompound/(1+float64(rates[i].Rate)/100)
+			taxes[i] += amount
+			nonCompound -= amount
+		}
+	}
+
+	regularRate := 1.0
+	for _, rate := range rates {
+		if !rate.Compound {
+			regularRate += float64(rate.Rate) / 100
+		}
+	}
+	for i, rate := range rates {
+		if !rate.Compound {
+			share := float64(rate.Rate) / 100 / regularRate
+			net := price - share*nonCompound
+			taxes[i] += price - net
+		}
+	}
+	return taxes
+}
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func sameRates(a, b []*woocommerce.Tax) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// round rounds the value to the number of decimals as PHP does. The value is first rounded
+// to 15 significant digits, so that floating point errors do not affect rounding of halves.
+// Halves are rounded away from zero, or towards zero if halfDown is set.
+func round(value float64, decimals int, halfDown bool) float64 {
+	scale := math.Pow(10, float64(decimals))
+	scaled, _ := strconv.ParseFloat(strconv.FormatFloat(value*scale, 'g', 15, 64), 64)
+
+	sign := 1.0
+	if scaled < 0 {
+		sign, scaled = -1, -scaled
+	}
+	rounded := math.Floor(scaled)
+	switch fraction := scaled - rounded; {
+	case fraction > 0.5, fraction == 0.5 && !halfDown:
+		rounded++
+	}
+	return sign * rounded / scale
+}
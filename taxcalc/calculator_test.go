@@ -0,0 +1,152 @@
+package taxcalc
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/internal/backend"
+	"github.com/zerodays/woocommerce-go/tax"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+// fixtures are tax rates of a store and calculations with expected results.
+// Expected results follow the formulas of WC_Tax and wc_get_price_including_tax.
+type fixtures struct {
+	Rates     []woocommerce.Tax `json:"rates"`
+	Scenarios []struct {
+		Name     string `json:"name"`
+		Settings struct {
+			PricesIncludeTax bool     `json:"prices_include_tax"`
+			Base             location `json:"base"`
+			RoundAtSubtotal  bool     `json:"round_at_subtotal"`
+			ShippingTaxClass string   `json:"shipping_tax_class"`
+		} `json:"settings"`
+		Cases []struct {
+			Name        string   `json:"name"`
+			Location    location `json:"location"`
+			Class       string   `json:"class"`
+			Shipping    bool     `json:"shipping"`
+			ItemClasses []string `json:"item_classes"`
+			Price       float64  `json:"price"`
+			Expected    struct {
+				Net   float64 `json:"net"`
+				Gross float64 `json:"gross"`
+				Total float64 `json:"total"`
+				Taxes []struct {
+					Rate   int     `json:"rate"`
+					Amount float64 `json:"amount"`
+				} `json:"taxes"`
+			} `json:"expected"`
+		} `json:"cases"`
+	} `json:"scenarios"`
+}
+
+type location struct {
+	Country  string `json:"country"`
+	State    string `json:"state"`
+	Postcode string `json:"postcode"`
+	City     string `json:"city"`
+}
+
+func (l location) Location() Location {
+	return Location{Country: l.Country, State: l.State, Postcode: l.Postcode, City: l.City}
+}
+
+func TestCalculator(t *testing.T) {
+	data, err := os.ReadFile("testdata/fixtures.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f fixtures
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := wctest.NewServer()
+	defer srv.Close()
+	// Fixtures refer to rates by their IDs, which the server assigns again.
+	ids := map[int]int{}
+	for _, rate := range f.Rates {
+		fixtureID := rate.ID
+		rate.ID = 0
+		id, err := srv.Add(wctest.ResourceTaxes, rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[id] = fixtureID
+	}
+	client := tax.New(backend.New(srv.URL, srv.ConsumerKey, srv.ConsumerSecret))
+
+	for _, scenario := range f.Scenarios {
+		var opts []Option
+		if scenario.Settings.PricesIncludeTax {
+			opts = append(opts, WithPricesIncludeTax(scenario.Settings.Base.Location()))
+		}
+		if scenario.Settings.RoundAtSubtotal {
+			opts = append(opts, WithRoundAtSubtotal())
+		}
+		if scenario.Settings.ShippingTaxClass != "" {
+			opts = append(opts, WithShippingTaxClass(scenario.Settings.ShippingTaxClass))
+		}
+		calc, err := Load(client, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tc := range scenario.Cases {
+			var result Result
+			if tc.Shipping {
+				result = calc.CalculateShipping(tc.Location.Location(), tc.Price, tc.ItemClasses)
+			} else {
+				result = calc.Calculate(tc.Location.Location(), tc.Class, tc.Price)
+			}
+
+			name := scenario.Name + "/" + tc.Name
+			expected := tc.Expected
+			if !equal(result.Net, expected.Net) || !equal(result.Gross, expected.Gross) || !equal(result.Total, expected.Total) {
+				t.Errorf("%s: expected net %v, gross %v, total %v, got %v, %v, %v",
+					name, expected.Net, expected.Gross, expected.Total, result.Net, result.Gross, result.Total)
+			}
+			if len(result.Taxes) != len(expected.Taxes) {
+				t.Errorf("%s: expected %d taxes, got %d", name, len(expected.Taxes), len(result.Taxes))
+				continue
+			}
+			for i, tax := range result.Taxes {
+				if ids[tax.Rate.ID] != expected.Taxes[i].Rate || !equal(tax.Amount, expected.Taxes[i].Amount) {
+					t.Errorf("%s: expected tax %v of rate %d, got %v of rate %d",
+						name, expected.Taxes[i].Amount, expected.Taxes[i].Rate, tax.Amount, ids[tax.Rate.ID])
+				}
+			}
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value    float64
+		decimals int
+		halfDown bool
+		expected float64
+	}{
+		{0.125, 2, false, 0.13},
+		{0.125, 2, true, 0.12},
+		{-0.125, 2, false, -0.13},
+		{-0.125, 2, true, -0.12},
+		{1.005, 2, false, 1.01},
+		{0.126, 2, true, 0.13},
+		{2.5, 0, false, 3},
+		{12.2 - 10, 2, true, 2.2},
+	}
+	for _, tc := range tests {
+		if rounded := round(tc.value, tc.decimals, tc.halfDown); !equal(rounded, tc.expected) {
+			t.Errorf("expected round(%v, %d, %v) to be %v, got %v", tc.value, tc.decimals, tc.halfDown, tc.expected, rounded)
+		}
+	}
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
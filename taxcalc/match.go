@@ -0,0 +1,243 @@
+package taxcalc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zerodays/woocommerce-go"
+)
+
+// classStandard is the slug of the standard tax class in the REST API.
+// Woocommerce stores it as an empty class internally.
+const classStandard = "standard"
+
+// Location is the address for which taxes are calculated.
+type Location struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country.
+	Country string
+	// State is the code of the state, as used by woocommerce.
+	State    string
+	Postcode string
+	City     string
+}
+
+// candidate is a rate that matches the location, with the number of matched
+// postcode and city rows, which woocommerce uses to prefer more specific rates.
+type candidate struct {
+	rate          *woocommerce.Tax
+	postcodeCount int
+	cityCount     int
+}
+
+// matchRates returns rates of the class that apply to the location, the same way as WC_Tax::find_rates does.
+// Rates are sorted by priority and specificity and only the first rate of each priority is kept.
+func matchRates(rates []*woocommerce.Tax, location Location, class string) []*woocommerce.Tax {
+	country := strings.ToUpper(strings.TrimSpace(location.Country))
+	if country == "" {
+		return nil
+	}
+	state := strings.ToUpper(strings.TrimSpace(location.State))
+	postcode := normalizePostcode(location.Postcode)
+	city := strings.ToUpper(strings.TrimSpace(location.City))
+	class = normalizeClass(class)
+
+	var candidates []candidate
+	for _, rate := range rates {
+		if normalizeClass(rate.Class) != class {
+			continue
+		}
+		if c := strings.ToUpper(rate.Country); c != "" && c != country {
+			continue
+		}
+		if s := strings.ToUpper(rate.State); s != "" && s != state {
+			continue
+		}
+
+		postcodes := 0
+		for _, pattern := range rate.Postcodes {
+			if matchPostcode(normalizePostcode(pattern), postcode) {
+				postcodes++
+			}
+		}
+		if len(rate.Postcodes) > 0 && postcodes == 0 {
+			continue
+		}
+
+		cities := 0
+		for _, c := range rate.Cities {
+			if strings.ToUpper(strings.TrimSpace(c)) == city {
+				cities++
+			}
+		}
+		if len(rate.Cities) > 0 && cities == 0 {
+			continue
+		}
+
+		// Woocommerce joins postcodes and cities in a single query,
+		// so each count is multiplied by the number of rows of the other location type.
+		c := candidate{rate: rate}
+		if postcodes > 0 {
+			c.postcodeCount = postcodes * atLeastOne(cities)
+		}
+		if cities > 0 {
+			c.cityCount = cities * atLeastOne(postcodes)
+		}
+		candidates = append(candidates, c)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return lessCandidate(candidates[i], candidates[j])
+	})
+
+	var matched []*woocommerce.Tax
+	priorities := map[int]bool{}
+	for _, c := range candidates {
+		if priorities[c.rate.Priority] {
+			continue
+		}
+		priorities[c.rate.Priority] = true
+		matched = append(matched, c.rate)
+	}
+	return matched
+}
+
+// lessCandidate mirrors WC_Tax::sort_rates_callback. Rates with lower priority come first.
+// Within a priority, rates with a country, a state, more postcodes and more cities are preferred.
+func lessCandidate(a, b candidate) bool {
+	if a.rate.Priority != b.rate.Priority {
+		return a.rate.Priority < b.rate.Priority
+	}
+
+	for _, pair := range [][2]string{
+		{strings.ToUpper(a.rate.Country), strings.ToUpper(b.rate.Country)},
+		{strings.ToUpper(a.rate.State), strings.ToUpper(b.rate.State)},
+	} {
+		if pair[0] == pair[1] {
+			continue
+		}
+		if pair[0] == "" {
+			return false
+		}
+		if pair[1] == "" {
+			return true
+		}
+		return pair[0] < pair[1]
+	}
+
+	if a.postcodeCount != b.postcodeCount {
+		return a.postcodeCount > b.postcodeCount
+	}
+	if a.cityCount != b.cityCount {
+		return a.cityCount > b.cityCount
+	}
+	return a.rate.Order < b.rate.Order
+}
+
+func atLeastOne(n int) int {
+	if n == 0 {
+		return 1
+	}
+	return n
+}
+
+// normalizeClass converts the standard class to its internal empty value.
+func normalizeClass(class string) string {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == classStandard {
+		return ""
+	}
+	return class
+}
+
+// normalizePostcode mirrors wc_normalize_postcode. It uppercases the postcode and removes spaces and dashes.
+func normalizePostcode(postcode string) string {
+	postcode = strings.ToUpper(strings.TrimSpace(postcode))
+	return strings.NewReplacer(" ", "", "\t", "", "-", "").Replace(postcode)
+}
+
+// matchPostcode checks if the normalized postcode matches the pattern of a tax rate.
+// Patterns are exact postcodes, prefixes ending with * and ranges, such as 1000...1999.
+//
+// Woocommerce formats postcodes of some countries before generating wildcards. Stored patterns are
+// normalized, so formatting only adds wildcards with spaces, which can never match and are not generated here.
+func matchPostcode(pattern, postcode string) bool {
+	if strings.Contains(pattern, "...") {
+		bounds := strings.Split(pattern, "...")
+		if len(bounds) != 2 {
+			return false
+		}
+		return inRange(postcode, strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1]))
+	}
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(postcode, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == postcode
+}
+
+// inRange mirrors the range comparison of wc_postcode_location_matcher. If a bound is not numeric,
+// the postcode and bounds are converted to numbers with wc_make_numeric_postcode and padded to the same length.
+func inRange(postcode, from, to string) bool {
+	if !isNumeric(from) || !isNumeric(to) {
+		postcode = numericPostcode(postcode)
+		from = padRight(numericPostcode(from), len(postcode))
+		to = padRight(numericPostcode(to), len(postcode))
+	}
+	if !isNumeric(postcode) {
+		// PHP compares a non-numeric string with numeric strings as strings.
+		return postcode >= from && postcode <= to
+	}
+	return compareNumeric(postcode, from) >= 0 && compareNumeric(postcode, to) <= 0
+}
+
+// numericPostcode mirrors wc_make_numeric_postcode. Digits and letters are converted to two digit numbers,
+// with A as 01 and Z as 26. Other characters are converted to 00.
+func numericPostcode(postcode string) string {
+	var b strings.Builder
+	for _, r := range postcode {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteByte('0')
+			b.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			n := int(r-'A') + 1
+			b.WriteByte(byte('0' + n/10))
+			b.WriteByte(byte('0' + n%10))
+		default:
+			b.WriteString("00")
+		}
+	}
+	return b.String()
+}
+
+// padRight pads the string with zeros to the given length, as str_pad does.
+func padRight(s string, length int) string {
+	if len(s) >= length {
+		return s
+	}
+	return s + strings.Repeat("0", length-len(s))
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// compareNumeric compares strings of digits by their numeric values, regardless of their length.
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
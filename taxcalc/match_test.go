@@ -0,0 +1,33 @@
+package taxcalc
+
+import "testing"
+
+func TestMatchPostcode(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		postcode string
+		expected bool
+	}{
+		{"1000", "1000", true},
+		{"1000", "10000", false},
+		{"90*", "90210", true},
+		{"90*", "91000", false},
+		{"1000...1999", "1500", true},
+		{"1000...1999", "999", false},
+		{"1000...1999", "2000", false},
+		{"BT1...BT99", "BT151AA", true},
+		{"BT1...BT99", "SW1A1AA", false},
+		{"BT1...BT99", "BS11AA", false},
+	}
+	for _, tc := range tests {
+		if matched := matchPostcode(tc.pattern, tc.postcode); matched != tc.expected {
+			t.Errorf("expected match of %s with %s to be %v", tc.pattern, tc.postcode, tc.expected)
+		}
+	}
+}
+
+func TestNormalizePostcode(t *testing.T) {
+	if postcode := normalizePostcode(" sw1a-1aa "); postcode != "SW1A1AA" {
+		t.Errorf("expected SW1A1AA, got %s", postcode)
+	}
+}
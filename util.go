@@ -8,6 +8,11 @@ import (
 
 const timeFormat = "2006-01-02T15:04:05"
 
+// Ptr returns a pointer to the given value. It is useful for setting fields of partial updates.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
 // Float is a support type that marshals itself to string in JSON.
 type Float float64
 
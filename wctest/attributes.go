@@ -0,0 +1,86 @@
+package wctest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	pathAttributes = "products/attributes"
+	pathTerms      = "products/attributes/{attribute_id}/terms"
+)
+
+// registerAttributes registers global product attributes and their terms.
+func (s *Server) registerAttributes() {
+	configs := []collectionConfig{
+		{
+			path:          pathAttributes,
+			defaultOrder:  "asc",
+			unpaged:       true,
+			invalidIDCode: "woocommerce_rest_invalid_id",
+			prepare:       prepareAttribute,
+			deleted:       attributeDeleted,
+		},
+		{
+			path:          pathTerms,
+			defaultOrder:  "asc",
+			invalidIDCode: "woocommerce_rest_term_invalid",
+			filters: map[string]string{
+				"slug": "slug",
+			},
+			prepare: prepareTerm,
+		},
+	}
+	for _, cfg := range configs {
+		s.configs[cfg.path] = cfg
+		s.registerCollection(cfg)
+	}
+}
+
+func prepareAttribute(s *Server, ids []int, obj, existing object) *apiError {
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return &apiError{http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): name"}
+	}
+
+	// Slugs are prefixed with pa_ and must be unique.
+	slug, _ := obj["slug"].(string)
+	if slug == "" {
+		slug = slugify(name)
+	}
+	slug = "pa_" + strings.TrimPrefix(slugify(slug), "pa_")
+	for id, a := range s.collections[pathAttributes].objects {
+		if objID, _ := intValue(obj["id"]); id != objID && a["slug"] == slug {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_cannot_create", fmt.Sprintf("Slug \"%s\" is already in use.", slug)}
+		}
+	}
+	obj["slug"] = slug
+
+	setDefault(obj, "type", "select")
+	setDefault(obj, "order_by", "menu_order")
+	setDefault(obj, "has_archives", false)
+	deleteDates(obj)
+	return nil
+}
+
+// attributeDeleted removes terms of the deleted attribute.
+func attributeDeleted(s *Server, ids []int, obj object) {
+	delete(s.collections, collectionKey(pathTerms, ids))
+}
+
+func prepareTerm(s *Server, ids []int, obj, existing object) *apiError {
+	if err := prepareTaxonomyTerm(s, collectionKey(pathTerms, ids), obj); err != nil {
+		return err
+	}
+
+	setDefault(obj, "menu_order", 0)
+	return nil
+}
+
+// deleteDates removes date fields from objects that do not have them, such as terms.
+func deleteDates(obj object) {
+	for _, field := range []string{"date_created", "date_created_gmt", "date_modified", "date_modified_gmt"} {
+		delete(obj, field)
+	}
+}
@@ -0,0 +1,337 @@
+package wctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	headerCartToken = "Cart-Token"
+	headerNonce     = "Nonce"
+
+	currencyCode      = "USD"
+	currencyMinorUnit = 2
+
+	// shippingRateID is the ID of the only shipping rate offered by the fake server.
+	shippingRateID = "flat_rate:1"
+)
+
+// cart is a Store API cart, identified by its cart token.
+type cart struct {
+	token string
+	// nonce must be sent with every request that modifies the cart.
+	nonce string
+
+	items    []*cartItem
+	coupons  []string
+	billing  map[string]interface{}
+	shipping map[string]interface{}
+	rateID   string
+}
+
+type cartItem struct {
+	key       string
+	id        int
+	quantity  int
+	variation []interface{}
+}
+
+func (s *Server) serveStore(w http.ResponseWriter, r *http.Request, path string) {
+	c := s.carts[r.Header.Get(headerCartToken)]
+
+	if path == "cart" && r.Method == http.MethodGet {
+		// Requests without a valid cart token start a new session.
+		if c == nil {
+			c = &cart{
+				token:  randomToken(),
+				nonce:  randomToken(),
+				rateID: shippingRateID,
+			}
+			s.carts[c.token] = c
+		}
+		s.writeCart(w, http.StatusOK, c)
+		return
+	}
+
+	if !strings.HasPrefix(path, "cart/") || r.Method != http.MethodPost {
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+		return
+	}
+
+	// Requests that modify the cart must carry the nonce of the cart.
+	nonce := r.Header.Get(headerNonce)
+	if nonce == "" {
+		writeError(w, http.StatusUnauthorized, "woocommerce_rest_missing_nonce", "Missing the Nonce header. This endpoint requires a valid nonce.")
+		return
+	}
+	if c == nil || nonce != c.nonce {
+		writeError(w, http.StatusForbidden, "woocommerce_rest_invalid_nonce", "Nonce is invalid.")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+			return
+		}
+	}
+
+	var err *apiError
+	switch strings.TrimPrefix(path, "cart/") {
+	case "add-item":
+		err = s.cartAddItem(c, body)
+	case "remove-item":
+		err = c.removeItem(fmt.Sprint(body["key"]))
+	case "update-item":
+		err = c.updateItem(fmt.Sprint(body["key"]), body["quantity"])
+	case "update-customer":
+		if billing, ok := body["billing_address"].(map[string]interface{}); ok {
+			c.billing = billing
+		}
+		if shipping, ok := body["shipping_address"].(map[string]interface{}); ok {
+			c.shipping = shipping
+		}
+	case "select-shipping-rate":
+		if body["rate_id"] != shippingRateID {
+			err = &apiError{http.StatusBadRequest, "woocommerce_rest_cart_invalid_rate", "Invalid rate_id provided."}
+		}
+	case "apply-coupon":
+		err = s.cartApplyCoupon(c, r.URL.Query().Get("code"))
+	case "remove-coupon":
+		err = c.removeCoupon(r.URL.Query().Get("code"))
+	default:
+		err = &apiError{http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method."}
+	}
+
+	if err != nil {
+		writeError(w, err.status, err.code, err.message)
+		return
+	}
+	s.writeCart(w, http.StatusCreated, c)
+}
+
+func (s *Server) cartAddItem(c *cart, body map[string]interface{}) *apiError {
+	id, _ := intValue(body["id"])
+	quantity, ok := intValue(body["quantity"])
+	if !ok {
+		quantity = 1
+	}
+	if s.findProduct(id) == nil {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_cart_invalid_product", "This product cannot be added to the cart."}
+	}
+
+	variation, _ := body["variation"].([]interface{})
+	for _, item := range c.items {
+		if item.id == id {
+			item.quantity += quantity
+			return nil
+		}
+	}
+
+	c.items = append(c.items, &cartItem{
+		key:       randomToken(),
+		id:        id,
+		quantity:  quantity,
+		variation: variation,
+	})
+	return nil
+}
+
+func (c *cart) removeItem(key string) *apiError {
+	for i, item := range c.items {
+		if item.key == key {
+			c.items = append(c.items[:i], c.items[i+1:]...)
+			return nil
+		}
+	}
+	return &apiError{http.StatusConflict, "woocommerce_rest_cart_invalid_key", "Cart item no longer exists or is invalid."}
+}
+
+func (c *cart) updateItem(key string, quantity interface{}) *apiError {
+	q, _ := intValue(quantity)
+	for _, item := range c.items {
+		if item.key == key {
+			item.quantity = q
+			return nil
+		}
+	}
+	return &apiError{http.StatusConflict, "woocommerce_rest_cart_invalid_key", "Cart item no longer exists or is invalid."}
+}
+
+func (s *Server) cartApplyCoupon(c *cart, code string) *apiError {
+	if s.findCoupon(code) == nil {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_cart_coupon_error", fmt.Sprintf(`Coupon "%s" does not exist!`, code)}
+	}
+	for _, applied := range c.coupons {
+		if strings.EqualFold(applied, code) {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_cart_coupon_error", fmt.Sprintf(`Coupon code "%s" already applied!`, code)}
+		}
+	}
+
+	c.coupons = append(c.coupons, strings.ToLower(code))
+	return nil
+}
+
+func (c *cart) removeCoupon(code string) *apiError {
+	for i, applied := range c.coupons {
+		if strings.EqualFold(applied, code) {
+			c.coupons = append(c.coupons[:i], c.coupons[i+1:]...)
+			return nil
+		}
+	}
+	return &apiError{http.StatusBadRequest, "woocommerce_rest_cart_coupon_invalid_code", "Coupon cannot be removed because it is not already applied to the cart."}
+}
+
+// findCoupon finds a coupon by its code. Coupon codes are case-insensitive.
+func (s *Server) findCoupon(code string) object {
+	c := s.collections["coupons"]
+	if c == nil {
+		return nil
+	}
+	for _, coupon := range c.objects {
+		if strings.EqualFold(fmt.Sprint(coupon["code"]), code) {
+			return coupon
+		}
+	}
+	return nil
+}
+
+// writeCart renders the cart in the format of the Store API.
+// Prices are in minor units and formatted as strings.
+func (s *Server) writeCart(w http.ResponseWriter, status int, c *cart) {
+	minor := func(price float64) string {
+		return strconv.Itoa(int(math.Round(price * math.Pow10(currencyMinorUnit))))
+	}
+
+	totalItems := 0.0
+	items := []interface{}{}
+	for _, item := range c.items {
+		product := s.findProduct(item.id)
+		if product == nil {
+			continue
+		}
+
+		price, _ := floatValue(product["price"])
+		regularPrice, _ := floatValue(product["regular_price"])
+		salePrice, _ := floatValue(product["sale_price"])
+		lineTotal := price * float64(item.quantity)
+		totalItems += lineTotal
+
+		variation := item.variation
+		if variation == nil {
+			variation = []interface{}{}
+		}
+		items = append(items, map[string]interface{}{
+			"key":       item.key,
+			"id":        item.id,
+			"quantity":  item.quantity,
+			"name":      product["name"],
+			"sku":       product["sku"],
+			"images":    []interface{}{},
+			"variation": variation,
+			"prices": map[string]interface{}{
+				"price":               minor(price),
+				"regular_price":       minor(regularPrice),
+				"sale_price":          minor(salePrice),
+				"currency_code":       currencyCode,
+				"currency_minor_unit": currencyMinorUnit,
+			},
+			"totals": map[string]interface{}{
+				"line_subtotal":       minor(lineTotal),
+				"line_subtotal_tax":   "0",
+				"line_total":          minor(lineTotal),
+				"line_total_tax":      "0",
+				"currency_code":       currencyCode,
+				"currency_minor_unit": currencyMinorUnit,
+			},
+		})
+	}
+
+	totalDiscount := 0.0
+	coupons := []interface{}{}
+	for _, code := range c.coupons {
+		coupon := s.findCoupon(code)
+		if coupon == nil {
+			continue
+		}
+
+		amount, _ := floatValue(coupon["amount"])
+		discount := 0.0
+		switch coupon["discount_type"] {
+		case "percent":
+			discount = totalItems * amount / 100
+		case "fixed_product":
+			for _, item := range c.items {
+				discount += amount * float64(item.quantity)
+			}
+		default:
+			discount = amount
+		}
+		if discount > totalItems-totalDiscount {
+			discount = totalItems - totalDiscount
+		}
+		totalDiscount += discount
+
+		coupons = append(coupons, map[string]interface{}{
+			"code":          code,
+			"discount_type": coupon["discount_type"],
+			"totals": map[string]interface{}{
+				"currency_code":       currencyCode,
+				"currency_minor_unit": currencyMinorUnit,
+				"total_discount":      minor(discount),
+				"total_discount_tax":  "0",
+			},
+		})
+	}
+
+	address := func(a map[string]interface{}) map[string]interface{} {
+		if a == nil {
+			return map[string]interface{}{}
+		}
+		return a
+	}
+
+	w.Header().Set(headerCartToken, c.token)
+	w.Header().Set(headerNonce, c.nonce)
+	writeJSON(w, status, map[string]interface{}{
+		"coupons":          coupons,
+		"items":            items,
+		"billing_address":  address(c.billing),
+		"shipping_address": address(c.shipping),
+		"shipping_rates": []interface{}{
+			map[string]interface{}{
+				"package_id": 0,
+				"name":       "Shipment 1",
+				"shipping_rates": []interface{}{
+					map[string]interface{}{
+						"rate_id":       shippingRateID,
+						"name":          "Flat rate",
+						"price":         "0",
+						"method_id":     "flat_rate",
+						"selected":      c.rateID == shippingRateID,
+						"currency_code": currencyCode,
+					},
+				},
+			},
+		},
+		"totals": map[string]interface{}{
+			"currency_code":       currencyCode,
+			"currency_minor_unit": currencyMinorUnit,
+			"total_items":         minor(totalItems),
+			"total_items_tax":     "0",
+			"total_fees":          "0",
+			"total_fees_tax":      "0",
+			"total_discount":      minor(totalDiscount),
+			"total_discount_tax":  "0",
+			"total_shipping":      "0",
+			"total_shipping_tax":  "0",
+			"total_price":         minor(totalItems - totalDiscount),
+			"total_tax":           "0",
+		},
+	})
+}
@@ -0,0 +1,645 @@
+package wctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPerPage = 10
+	maxPerPage     = 100
+	maxBatchSize   = 100
+)
+
+// object is a single JSON object stored by the server.
+type object map[string]interface{}
+
+// collection holds objects of a single REST collection, for instance all orders
+// or all variations of a single product.
+type collection struct {
+	objects map[int]object
+}
+
+// apiError is an error returned by the REST API.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+// collectionConfig configures the behaviour of a REST collection.
+type collectionConfig struct {
+	// path is the pattern of the collection, for instance products/{product_id}/variations.
+	path string
+	// trash is true if objects can be moved to trash instead of being deleted.
+	trash bool
+	// defaultOrder is the default order of listed objects, asc or desc.
+	defaultOrder string
+	// unpaged is true if all objects are listed in a single response, regardless of pagination parameters.
+	unpaged bool
+	// customStatus is true if objects are filtered by their status in match instead of the default status filter.
+	customStatus bool
+	// invalidIDCode is the error code returned when the object does not exist.
+	invalidIDCode string
+	// filters maps query parameters to fields of objects. Listed objects must have the value of the field
+	// equal to one of the comma separated values of the parameter.
+	filters map[string]string
+	// match is an additional filter of listed objects.
+	match func(s *Server, obj object, query url.Values) bool
+	// prepare validates the object and fills computed fields before it is stored.
+	// Existing is nil if the object is being created.
+	prepare func(s *Server, ids []int, obj, existing object) *apiError
+	// deleted is called after the object is deleted.
+	deleted func(s *Server, ids []int, obj object)
+}
+
+// registerCollection registers list, create, batch, retrieve, update and delete routes of the collection.
+func (s *Server) registerCollection(cfg collectionConfig) {
+	s.handle(cfg.path, http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.listObjects(w, r, cfg, ids)
+	})
+	s.handle(cfg.path, http.MethodPost, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.createObject(w, r, cfg, ids)
+	})
+	s.handle(cfg.path+"/batch", http.MethodPost, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.batchObjects(w, r, cfg, ids)
+	})
+	s.handle(cfg.path+"/{id}", http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.retrieveObject(w, cfg, ids)
+	})
+	s.handle(cfg.path+"/{id}", http.MethodPut, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.updateObject(w, r, cfg, ids)
+	})
+	s.handle(cfg.path+"/{id}", http.MethodDelete, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		s.deleteObject(w, r, cfg, ids)
+	})
+}
+
+// collectionKey builds the key of the collection by replacing ids in the pattern.
+func collectionKey(pattern string, ids []int) string {
+	segments := strings.Split(pattern, "/")
+	i := 0
+	for j, segment := range segments {
+		if strings.HasPrefix(segment, "{") && i < len(ids) {
+			segments[j] = strconv.Itoa(ids[i])
+			i++
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// collection returns the collection with the given key. If the collection is nested,
+// its parent object must exist, otherwise nil is returned.
+func (s *Server) collection(pattern string, ids []int) *collection {
+	segments := strings.Split(pattern, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if strings.HasPrefix(segments[i], "{") {
+			parent := s.collections[collectionKey(strings.Join(segments[:i], "/"), ids)]
+			if parent == nil || parent.objects[ids[len(ids)-1]] == nil {
+				return nil
+			}
+			break
+		}
+	}
+
+	key := collectionKey(pattern, ids)
+	c, ok := s.collections[key]
+	if !ok {
+		c = &collection{objects: make(map[int]object)}
+		s.collections[key] = c
+	}
+	return c
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids)
+	if c == nil {
+		writeError(w, http.StatusNotFound, "woocommerce_rest_invalid_id", "Invalid ID.")
+		return
+	}
+	s.writeList(w, r, cfg, c.objects)
+}
+
+// writeList filters, sorts and paginates objects according to the query of the request.
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, cfg collectionConfig, all map[int]object) {
+	query := r.URL.Query()
+	page, perPage, err := pagination(query)
+	if err != nil {
+		writeError(w, err.status, err.code, err.message)
+		return
+	}
+
+	// Filter objects.
+	var objects []object
+	for _, obj := range all {
+		if s.matches(cfg, obj, query) {
+			objects = append(objects, obj)
+		}
+	}
+	sortObjects(objects, query, cfg.defaultOrder)
+
+	// Paginate objects.
+	total := len(objects)
+	if cfg.unpaged && total > 0 {
+		page, perPage = 1, total
+	}
+	totalPages := int(math.Ceil(float64(total) / float64(perPage)))
+	start := (page - 1) * perPage
+	end := start + perPage
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+
+	w.Header().Set("X-WP-Total", strconv.Itoa(total))
+	w.Header().Set("X-WP-TotalPages", strconv.Itoa(totalPages))
+	if page < totalPages {
+		next := *r.URL
+		q := next.Query()
+		q.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = q.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<%s%s>; rel="next"`, s.URL, next.RequestURI()))
+	}
+
+	result := objects[start:end]
+	if result == nil {
+		result = []object{}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func pagination(query url.Values) (page, perPage int, err *apiError) {
+	page, perPage = 1, defaultPerPage
+	if v := query.Get("page"); v != "" {
+		p, convErr := strconv.Atoi(v)
+		if convErr != nil || p < 1 {
+			return 0, 0, &apiError{http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): page"}
+		}
+		page = p
+	}
+	if v := query.Get("per_page"); v != "" {
+		p, convErr := strconv.Atoi(v)
+		if convErr != nil || p < 1 || p > maxPerPage {
+			return 0, 0, &apiError{http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): per_page"}
+		}
+		perPage = p
+	}
+	return page, perPage, nil
+}
+
+// matches checks if the object matches list query parameters.
+func (s *Server) matches(cfg collectionConfig, obj object, query url.Values) bool {
+	id, _ := intValue(obj["id"])
+
+	if v := query.Get("include"); v != "" && !containsValue(v, strconv.Itoa(id)) {
+		return false
+	}
+	if v := query.Get("exclude"); v != "" && containsValue(v, strconv.Itoa(id)) {
+		return false
+	}
+
+	if v := query.Get("search"); v != "" && !searchMatches(obj, strings.ToLower(v)) {
+		return false
+	}
+
+	if _, ok := obj["status"]; ok && !cfg.customStatus {
+		status := query.Get("status")
+		if status == "" && cfg.trash && obj["status"] == "trash" {
+			return false
+		}
+		if status != "" && status != "any" && !containsValue(status, fmt.Sprint(obj["status"])) {
+			return false
+		}
+	}
+
+	for param, field := range cfg.filters {
+		if v := query.Get(param); v != "" && !containsValue(v, fmt.Sprint(obj[field])) {
+			return false
+		}
+	}
+
+	dates := []struct {
+		param, field string
+		after        bool
+	}{
+		{"after", "date_created", true},
+		{"before", "date_created", false},
+		{"modified_after", "date_modified", true},
+		{"modified_before", "date_modified", false},
+	}
+	for _, d := range dates {
+		v := query.Get(d.param)
+		if v == "" {
+			continue
+		}
+		if !matchesDate(obj, d.field, v, d.after, query.Get("dates_are_gmt") == "true") {
+			return false
+		}
+	}
+
+	if cfg.match != nil && !cfg.match(s, obj, query) {
+		return false
+	}
+
+	return true
+}
+
+// searchMatches checks if a string field of the object contains the search string. Fields of nested objects,
+// such as billing addresses of orders, are searched as well.
+func searchMatches(obj map[string]interface{}, search string) bool {
+	for _, field := range obj {
+		switch field := field.(type) {
+		case string:
+			if strings.Contains(strings.ToLower(field), search) {
+				return true
+			}
+		case map[string]interface{}:
+			if searchMatches(field, search) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesDate compares the date field of the object with the date parameter.
+func matchesDate(obj object, field, param string, after, gmt bool) bool {
+	limit, err := time.Parse(time.RFC3339, param)
+	if err != nil {
+		limit, err = time.Parse(timeFormat, param)
+		if err != nil {
+			return false
+		}
+	} else {
+		gmt = true
+	}
+
+	if gmt {
+		field += "_gmt"
+	}
+	str, _ := obj[field].(string)
+	value, err := time.Parse(timeFormat, str)
+	if err != nil {
+		return false
+	}
+
+	if after {
+		return value.After(limit)
+	}
+	return value.Before(limit)
+}
+
+func sortObjects(objects []object, query url.Values, defaultOrder string) {
+	order := query.Get("order")
+	if order == "" {
+		order = defaultOrder
+	}
+
+	orderBy := query.Get("orderby")
+	key := func(obj object) string {
+		switch orderBy {
+		case "name", "title", "slug", "email", "code":
+			field := orderBy
+			if field == "title" {
+				field = "name"
+			}
+			return strings.ToLower(fmt.Sprint(obj[field]))
+		case "menu_order", "count":
+			value, _ := intValue(obj[orderBy])
+			return fmt.Sprintf("%020d", value)
+		default:
+			id, _ := intValue(obj["id"])
+			return fmt.Sprintf("%020d", id)
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		if order == "desc" {
+			return key(objects[i]) > key(objects[j])
+		}
+		return key(objects[i]) < key(objects[j])
+	})
+}
+
+func (s *Server) createObject(w http.ResponseWriter, r *http.Request, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids)
+	if c == nil {
+		writeError(w, http.StatusNotFound, "woocommerce_rest_invalid_id", "Invalid ID.")
+		return
+	}
+
+	var obj object
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+		return
+	}
+
+	created, apiErr := s.create(cfg, c, ids, obj)
+	if apiErr != nil {
+		writeError(w, apiErr.status, apiErr.code, apiErr.message)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) create(cfg collectionConfig, c *collection, ids []int, obj object) (object, *apiError) {
+	if obj == nil {
+		return nil, &apiError{http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed."}
+	}
+	if id, ok := intValue(obj["id"]); ok && id != 0 {
+		return nil, &apiError{http.StatusBadRequest, "woocommerce_rest_object_exists", "Cannot create existing resource."}
+	}
+
+	local, gmt := s.now()
+	obj["id"] = s.newID()
+	obj["date_created"], obj["date_created_gmt"] = local, gmt
+	obj["date_modified"], obj["date_modified_gmt"] = local, gmt
+
+	if cfg.prepare != nil {
+		if err := cfg.prepare(s, ids, obj, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	id, _ := intValue(obj["id"])
+	c.objects[id] = obj
+	return obj, nil
+}
+
+func (s *Server) retrieveObject(w http.ResponseWriter, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids[:len(ids)-1])
+	if c == nil || c.objects[ids[len(ids)-1]] == nil {
+		writeError(w, http.StatusNotFound, cfg.invalidIDCode, "Invalid ID.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, c.objects[ids[len(ids)-1]])
+}
+
+func (s *Server) updateObject(w http.ResponseWriter, r *http.Request, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids[:len(ids)-1])
+	if c == nil {
+		writeError(w, http.StatusNotFound, cfg.invalidIDCode, "Invalid ID.")
+		return
+	}
+
+	var changes object
+	if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+		return
+	}
+
+	updated, apiErr := s.update(cfg, c, ids[:len(ids)-1], ids[len(ids)-1], changes)
+	if apiErr != nil {
+		writeError(w, apiErr.status, apiErr.code, apiErr.message)
+		return
+	}
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (s *Server) update(cfg collectionConfig, c *collection, ids []int, id int, changes object) (object, *apiError) {
+	existing := c.objects[id]
+	if existing == nil {
+		return nil, &apiError{http.StatusBadRequest, cfg.invalidIDCode, "Invalid ID."}
+	}
+
+	// Merge changes into a copy, so that the stored object is not modified if the update fails.
+	obj := make(object, len(existing))
+	for key, value := range existing {
+		obj[key] = value
+	}
+	for key, value := range changes {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			// Nested objects, such as addresses, are updated field by field.
+			merged := map[string]interface{}{}
+			if e, ok := existing[key].(map[string]interface{}); ok {
+				for k, ev := range e {
+					merged[k] = ev
+				}
+			}
+			for k, nv := range v {
+				merged[k] = nv
+			}
+			obj[key] = merged
+		default:
+			if key == "meta_data" {
+				obj[key] = s.mergeMetaData(existing[key], value)
+			} else {
+				obj[key] = value
+			}
+		}
+	}
+	obj["id"] = id
+
+	local, gmt := s.now()
+	obj["date_modified"], obj["date_modified_gmt"] = local, gmt
+
+	if cfg.prepare != nil {
+		if err := cfg.prepare(s, ids, obj, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	c.objects[id] = obj
+	return obj, nil
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *http.Request, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids[:len(ids)-1])
+	if c == nil {
+		writeError(w, http.StatusNotFound, cfg.invalidIDCode, "Invalid ID.")
+		return
+	}
+
+	force := r.URL.Query().Get("force")
+	deleted, apiErr := s.delete(cfg, c, ids[:len(ids)-1], ids[len(ids)-1], force == "true" || force == "1")
+	if apiErr != nil {
+		writeError(w, apiErr.status, apiErr.code, apiErr.message)
+		return
+	}
+	writeJSON(w, http.StatusOK, deleted)
+}
+
+func (s *Server) delete(cfg collectionConfig, c *collection, ids []int, id int, force bool) (object, *apiError) {
+	obj := c.objects[id]
+	if obj == nil {
+		return nil, &apiError{http.StatusNotFound, cfg.invalidIDCode, "Invalid ID."}
+	}
+
+	if !force {
+		if !cfg.trash {
+			return nil, &apiError{http.StatusNotImplemented, "woocommerce_rest_trash_not_supported", "Resource does not support trashing."}
+		}
+		if obj["status"] == "trash" {
+			return nil, &apiError{http.StatusGone, "woocommerce_rest_already_trashed", "The resource has already been deleted."}
+		}
+		obj["status"] = "trash"
+		return obj, nil
+	}
+
+	delete(c.objects, id)
+	if cfg.deleted != nil {
+		cfg.deleted(s, append(append([]int{}, ids...), id), obj)
+	}
+	return obj, nil
+}
+
+func (s *Server) batchObjects(w http.ResponseWriter, r *http.Request, cfg collectionConfig, ids []int) {
+	c := s.collection(cfg.path, ids)
+	if c == nil {
+		writeError(w, http.StatusNotFound, "woocommerce_rest_invalid_id", "Invalid ID.")
+		return
+	}
+
+	var req struct {
+		Create []object      `json:"create"`
+		Update []object      `json:"update"`
+		Delete []interface{} `json:"delete"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+		return
+	}
+
+	if len(req.Create)+len(req.Update)+len(req.Delete) > maxBatchSize {
+		writeError(w, http.StatusRequestEntityTooLarge, "woocommerce_rest_request_entity_too_large",
+			fmt.Sprintf("Unable to accept more than %d items for this request.", maxBatchSize))
+		return
+	}
+
+	resp := map[string][]interface{}{}
+	for _, obj := range req.Create {
+		created, err := s.create(cfg, c, ids, obj)
+		resp["create"] = append(resp["create"], batchResult(0, created, err))
+	}
+	for _, obj := range req.Update {
+		id, _ := intValue(obj["id"])
+		updated, err := s.update(cfg, c, ids, id, obj)
+		resp["update"] = append(resp["update"], batchResult(id, updated, err))
+	}
+	for _, v := range req.Delete {
+		id, _ := intValue(v)
+		deleted, err := s.delete(cfg, c, ids, id, true)
+		resp["delete"] = append(resp["delete"], batchResult(id, deleted, err))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func batchResult(id int, obj object, err *apiError) interface{} {
+	if err != nil {
+		return map[string]interface{}{
+			"id":    id,
+			"error": errorObject(err.status, err.code, err.message),
+		}
+	}
+	return obj
+}
+
+// containsValue checks if the comma separated list contains the value.
+func containsValue(list, value string) bool {
+	for _, v := range strings.Split(list, ",") {
+		if strings.TrimSpace(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// intValue converts a JSON value to int. Woocommerce sometimes sends numbers as strings.
+func intValue(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// floatValue converts a JSON value to float64. Prices are usually sent as strings.
+func floatValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// formatPrice formats the price as woocommerce does.
+func formatPrice(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+// mergeMetaData merges posted meta data into existing meta data. Meta data with an ID is updated,
+// or removed if it has no value. Meta data without an ID is updated by its key or added.
+func (s *Server) mergeMetaData(existing, posted interface{}) []interface{} {
+	result := []interface{}{}
+	existingMeta, _ := existing.([]interface{})
+	for _, v := range existingMeta {
+		meta := map[string]interface{}{}
+		for key, value := range v.(map[string]interface{}) {
+			meta[key] = value
+		}
+		result = append(result, meta)
+	}
+
+	postedMeta, _ := posted.([]interface{})
+	for _, v := range postedMeta {
+		p, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := intValue(p["id"])
+		found := -1
+		for i, meta := range result {
+			m := meta.(map[string]interface{})
+			metaID, _ := intValue(m["id"])
+			if (id != 0 && metaID == id) || (id == 0 && m["key"] == p["key"]) {
+				found = i
+				break
+			}
+		}
+
+		switch {
+		case id != 0 && p["value"] == nil:
+			if found >= 0 {
+				result = append(result[:found], result[found+1:]...)
+			}
+		case found >= 0:
+			meta := result[found].(map[string]interface{})
+			for key, value := range p {
+				meta[key] = value
+			}
+		case id == 0:
+			result = append(result, map[string]interface{}{
+				"id":    s.newID(),
+				"key":   p["key"],
+				"value": p["value"],
+			})
+		}
+	}
+
+	return result
+}
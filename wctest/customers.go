@@ -0,0 +1,114 @@
+package wctest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// registerCustomers registers routes of customers that are not part of the customer collection.
+func (s *Server) registerCustomers() {
+	cfg := s.configs[string(ResourceCustomers)]
+
+	// Woocommerce validates the user to which posts of the deleted customer are reassigned.
+	s.handle(cfg.path+"/{id}", http.MethodDelete, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		if v := r.URL.Query().Get("reassign"); v != "" {
+			reassign, _ := strconv.Atoi(v)
+			if reassign == ids[0] || s.collection(cfg.path, nil).objects[reassign] == nil {
+				writeError(w, http.StatusBadRequest, "woocommerce_rest_customer_invalid_reassign", "Invalid resource id for reassignment.")
+				return
+			}
+		}
+		s.deleteObject(w, r, cfg, ids)
+	})
+
+	s.handle(cfg.path+"/{id}/downloads", http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		if s.collection(cfg.path, nil).objects[ids[0]] == nil {
+			writeError(w, http.StatusNotFound, cfg.invalidIDCode, "Invalid resource ID.")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.customerDownloads(ids[0]))
+	})
+}
+
+// customerDeleted detaches orders from the deleted customer, so they become orders of a guest.
+func customerDeleted(s *Server, ids []int, obj object) {
+	for _, order := range s.collection(string(ResourceOrders), nil).objects {
+		if customerID, _ := intValue(order["customer_id"]); customerID == ids[0] {
+			order["customer_id"] = 0
+		}
+	}
+}
+
+// customerDownloads lists files of downloadable products bought by the customer. Woocommerce grants
+// access to the files when the order is paid, so only processing and completed orders are considered.
+func (s *Server) customerDownloads(customerID int) []interface{} {
+	orders := s.collection(string(ResourceOrders), nil).objects
+	orderIDs := make([]int, 0, len(orders))
+	for id, order := range orders {
+		if c, _ := intValue(order["customer_id"]); c == customerID && (order["status"] == "processing" || order["status"] == "completed") {
+			orderIDs = append(orderIDs, id)
+		}
+	}
+	sort.Ints(orderIDs)
+
+	downloads := []interface{}{}
+	for _, orderID := range orderIDs {
+		order := orders[orderID]
+		items, _ := order["line_items"].([]interface{})
+		for _, v := range items {
+			item := v.(map[string]interface{})
+			productID, _ := intValue(item["variation_id"])
+			if productID == 0 {
+				productID, _ = intValue(item["product_id"])
+			}
+			product := s.findProduct(productID)
+			if product == nil || product["downloadable"] != true {
+				continue
+			}
+
+			remaining := "unlimited"
+			if limit, _ := intValue(product["download_limit"]); limit > 0 {
+				remaining = strconv.Itoa(limit)
+			}
+			expires, expiresGMT := "never", "never"
+			if days, _ := intValue(product["download_expiry"]); days > 0 {
+				expires = addDays(order["date_created"], days)
+				expiresGMT = addDays(order["date_created_gmt"], days)
+			}
+
+			files, _ := product["downloads"].([]interface{})
+			for _, f := range files {
+				file := f.(map[string]interface{})
+				downloads = append(downloads, map[string]interface{}{
+					"download_id":         file["id"],
+					"download_url":        fmt.Sprintf("%s/?download_file=%d&order=%s&key=%s", s.URL, productID, order["order_key"], file["id"]),
+					"product_id":          productID,
+					"product_name":        product["name"],
+					"download_name":       fmt.Sprintf("%s &ndash; %s", product["name"], file["name"]),
+					"order_id":            orderID,
+					"order_key":           order["order_key"],
+					"downloads_remaining": remaining,
+					"access_expires":      expires,
+					"access_expires_gmt":  expiresGMT,
+					"file": map[string]interface{}{
+						"name": file["name"],
+						"file": file["file"],
+					},
+				})
+			}
+		}
+	}
+	return downloads
+}
+
+// addDays adds days to the date formatted by the server.
+func addDays(date interface{}, days int) string {
+	t, err := time.Parse(timeFormat, fmt.Sprint(date))
+	if err != nil {
+		return "never"
+	}
+	return t.AddDate(0, 0, days).Format(timeFormat)
+}
@@ -0,0 +1,59 @@
+package wctest
+
+import (
+	"net/http"
+	"net/url"
+)
+
+const pathNotes = "orders/{order_id}/notes"
+
+// registerNotes registers notes of orders.
+func (s *Server) registerNotes() {
+	cfg := collectionConfig{
+		path:          pathNotes,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_invalid_id",
+		match:         matchNote,
+		prepare:       prepareNote,
+	}
+	s.configs[cfg.path] = cfg
+	s.registerCollection(cfg)
+
+	// Notes can not be updated.
+	s.handle(cfg.path+"/{id}", http.MethodPut, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+	})
+}
+
+func prepareNote(s *Server, ids []int, obj, existing object) *apiError {
+	if existing != nil {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_cannot_edit", "Notes can not be updated."}
+	}
+	if note, _ := obj["note"].(string); note == "" {
+		return &apiError{http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): note"}
+	}
+
+	setDefault(obj, "customer_note", false)
+	if byUser, _ := obj["added_by_user"].(bool); byUser {
+		obj["author"] = "admin"
+	} else {
+		obj["author"] = "WooCommerce"
+	}
+	delete(obj, "added_by_user")
+	delete(obj, "date_modified")
+	delete(obj, "date_modified_gmt")
+	return nil
+}
+
+// matchNote filters notes by their type, which is any, customer or internal.
+func matchNote(s *Server, obj object, query url.Values) bool {
+	customer, _ := obj["customer_note"].(bool)
+	switch query.Get("type") {
+	case "customer":
+		return customer
+	case "internal":
+		return !customer
+	default:
+		return true
+	}
+}
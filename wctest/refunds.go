@@ -0,0 +1,182 @@
+package wctest
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+)
+
+const pathRefunds = "orders/{order_id}/refunds"
+
+// registerRefunds registers refunds of orders and the global refunds listing.
+func (s *Server) registerRefunds() {
+	cfg := collectionConfig{
+		path:          pathRefunds,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_invalid_id",
+		filters: map[string]string{
+			"parent": "parent_id",
+		},
+		prepare: prepareRefund,
+		deleted: refundDeleted,
+	}
+	s.configs[cfg.path] = cfg
+	s.registerCollection(cfg)
+
+	// Refunds can not be updated.
+	s.handle(cfg.path+"/{id}", http.MethodPut, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+	})
+
+	s.handle("refunds", http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		all := map[int]object{}
+		for key, c := range s.collections {
+			if strings.HasPrefix(key, "orders/") && strings.HasSuffix(key, "/refunds") {
+				for id, obj := range c.objects {
+					all[id] = obj
+				}
+			}
+		}
+		s.writeList(w, r, cfg, all)
+	})
+}
+
+// prepareRefund validates the refund, computes its amount from refunded lines and updates the order.
+func prepareRefund(s *Server, ids []int, obj, existing object) *apiError {
+	if existing != nil {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_cannot_edit", "Refunds can not be updated."}
+	}
+
+	order := s.collections[string(ResourceOrders)].objects[ids[0]]
+
+	// Build refunded lines from lines of the order.
+	lines := map[int]map[string]interface{}{}
+	for _, key := range []string{"line_items", "shipping_lines", "fee_lines"} {
+		orderLines, _ := order[key].([]interface{})
+		for _, v := range orderLines {
+			line := v.(map[string]interface{})
+			id, _ := intValue(line["id"])
+			lines[id] = line
+		}
+	}
+
+	linesTotal := 0.0
+	var refundLines []interface{}
+	posted, _ := obj["line_items"].([]interface{})
+	for _, v := range posted {
+		p, _ := v.(map[string]interface{})
+		id, _ := intValue(p["id"])
+		line := lines[id]
+		if line == nil {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_invalid_order_refund", fmt.Sprintf("Invalid line item ID %d.", id)}
+		}
+
+		quantity, _ := intValue(p["quantity"])
+		refundTotal, _ := floatValue(p["refund_total"])
+		taxTotal := 0.0
+		taxes, _ := p["refund_tax"].([]interface{})
+		for _, t := range taxes {
+			tax, _ := t.(map[string]interface{})
+			amount, _ := floatValue(tax["refund_total"])
+			taxTotal += amount
+		}
+		linesTotal += refundTotal + taxTotal
+
+		refundLines = append(refundLines, map[string]interface{}{
+			"id":           s.newID(),
+			"name":         line["name"],
+			"product_id":   line["product_id"],
+			"variation_id": line["variation_id"],
+			"quantity":     -quantity,
+			"subtotal":     formatPrice(-refundTotal),
+			"total":        formatPrice(-refundTotal),
+			"total_tax":    formatPrice(-taxTotal),
+			"refund_total": refundTotal,
+			"taxes":        []interface{}{},
+			"meta_data":    []interface{}{},
+		})
+	}
+	if refundLines == nil {
+		refundLines = []interface{}{}
+	}
+
+	amount, ok := floatValue(obj["amount"])
+	if !ok {
+		amount = linesTotal
+	}
+	if amount <= 0 {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_invalid_order_refund", "Refund amount must be greater than zero."}
+	}
+
+	orderTotal, _ := floatValue(order["total"])
+	refunded := 0.0
+	refunds, _ := order["refunds"].([]interface{})
+	for _, v := range refunds {
+		total, _ := floatValue(v.(map[string]interface{})["total"])
+		refunded -= total
+	}
+	if amount > orderTotal-refunded+0.001 {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_invalid_order_refund", "Invalid refund amount."}
+	}
+
+	// Restock refunded items.
+	if restock, _ := obj["api_restock"].(bool); restock {
+		for _, v := range refundLines {
+			line := v.(map[string]interface{})
+			productID, _ := intValue(line["product_id"])
+			if variationID, _ := intValue(line["variation_id"]); variationID != 0 {
+				productID = variationID
+			}
+			product := s.findProduct(productID)
+			if manage, _ := product["manage_stock"].(bool); manage {
+				stock, _ := intValue(product["stock_quantity"])
+				quantity, _ := intValue(line["quantity"])
+				product["stock_quantity"] = stock - quantity
+				prepareStock(product)
+			}
+		}
+	}
+
+	refundedPayment, _ := obj["api_refund"].(bool)
+	delete(obj, "api_refund")
+	delete(obj, "api_restock")
+
+	obj["parent_id"] = ids[0]
+	obj["amount"] = formatPrice(amount)
+	obj["refunded_payment"] = refundedPayment
+	obj["line_items"] = refundLines
+	setDefault(obj, "reason", "")
+	setDefault(obj, "refunded_by", 0)
+	setDefault(obj, "meta_data", []interface{}{})
+	obj["shipping_lines"] = []interface{}{}
+	obj["tax_lines"] = []interface{}{}
+	obj["fee_lines"] = []interface{}{}
+
+	// Add the refund to the order. Fully refunded orders are marked as refunded.
+	order["refunds"] = append(refunds, map[string]interface{}{
+		"id":     obj["id"],
+		"reason": obj["reason"],
+		"total":  formatPrice(-amount),
+	})
+	if math.Abs(orderTotal-refunded-amount) < 0.001 {
+		order["status"] = "refunded"
+	}
+	return nil
+}
+
+func refundDeleted(s *Server, ids []int, obj object) {
+	order := s.collections[string(ResourceOrders)].objects[ids[0]]
+	if order == nil {
+		return
+	}
+
+	refunds := []interface{}{}
+	existing, _ := order["refunds"].([]interface{})
+	for _, v := range existing {
+		if id, _ := intValue(v.(map[string]interface{})["id"]); id != ids[1] {
+			refunds = append(refunds, v)
+		}
+	}
+	order["refunds"] = refunds
+}
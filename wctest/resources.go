@@ -0,0 +1,621 @@
+package wctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Resource is a REST API collection of the fake server.
+type Resource string
+
+const (
+	ResourceOrders    Resource = "orders"
+	ResourceProducts  Resource = "products"
+	ResourceCustomers Resource = "customers"
+	ResourceTaxes     Resource = "taxes"
+	ResourceCoupons   Resource = "coupons"
+)
+
+const pathVariations = "products/{product_id}/variations"
+
+func (s *Server) registerRoutes() {
+	s.configs = map[string]collectionConfig{}
+	register := func(cfg collectionConfig) {
+		s.configs[cfg.path] = cfg
+		s.registerCollection(cfg)
+	}
+
+	register(collectionConfig{
+		path:          string(ResourceOrders),
+		trash:         true,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_shop_order_invalid_id",
+		filters: map[string]string{
+			"customer": "customer_id",
+			"parent":   "parent_id",
+		},
+		match:   matchOrder,
+		prepare: prepareOrder,
+	})
+	register(collectionConfig{
+		path:          string(ResourceProducts),
+		trash:         true,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_product_invalid_id",
+		filters: map[string]string{
+			"sku":          "sku",
+			"slug":         "slug",
+			"type":         "type",
+			"parent":       "parent_id",
+			"featured":     "featured",
+			"stock_status": "stock_status",
+			"tax_class":    "tax_class",
+		},
+		match:   matchProduct,
+		prepare: prepareProduct,
+	})
+	register(collectionConfig{
+		path:          pathVariations,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_product_variation_invalid_id",
+		filters: map[string]string{
+			"sku":          "sku",
+			"stock_status": "stock_status",
+		},
+		prepare: prepareVariation,
+		deleted: variationDeleted,
+	})
+	register(collectionConfig{
+		path:          string(ResourceCustomers),
+		defaultOrder:  "asc",
+		invalidIDCode: "woocommerce_rest_invalid_id",
+		filters: map[string]string{
+			"email": "email",
+		},
+		match:   matchCustomer,
+		prepare: prepareCustomer,
+		deleted: customerDeleted,
+	})
+	register(collectionConfig{
+		path:          string(ResourceTaxes),
+		defaultOrder:  "asc",
+		invalidIDCode: "woocommerce_rest_invalid_id",
+		filters: map[string]string{
+			"class": "class",
+		},
+		prepare: prepareTax,
+	})
+	register(collectionConfig{
+		path:          string(ResourceCoupons),
+		trash:         true,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_shop_coupon_invalid_id",
+		match:         matchCoupon,
+		prepare:       prepareCoupon,
+	})
+
+	// Woocommerce returns variations from the product endpoint as well.
+	s.handle(string(ResourceProducts)+"/{id}", http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		if obj := s.findProduct(ids[0]); obj != nil {
+			if _, ok := s.collections[string(ResourceProducts)].objects[ids[0]]; !ok {
+				variation := make(object, len(obj)+1)
+				for key, value := range obj {
+					variation[key] = value
+				}
+				variation["type"] = "variation"
+				obj = variation
+			}
+			writeJSON(w, http.StatusOK, obj)
+			return
+		}
+		writeError(w, http.StatusNotFound, "woocommerce_rest_product_invalid_id", "Invalid ID.")
+	})
+
+	s.registerRefunds()
+	s.registerNotes()
+	s.registerAttributes()
+	s.registerTaxonomies()
+	s.registerReviews()
+	s.registerCustomers()
+	s.registerTaxClasses()
+}
+
+// Add adds the object to the collection of the resource and returns its ID.
+// The object is marshalled to JSON, so types of the woocommerce package can be used.
+// It is processed the same way as objects created through the API.
+func (s *Server) Add(resource Resource, v interface{}) (int, error) {
+	return s.add(string(resource), nil, v)
+}
+
+// AddVariation adds the variation to the product with the given ID and returns the ID of the variation.
+func (s *Server) AddVariation(productID int, v interface{}) (int, error) {
+	return s.add(pathVariations, []int{productID}, v)
+}
+
+func (s *Server) add(path string, ids []int, v interface{}) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, err := toObject(v)
+	if err != nil {
+		return 0, err
+	}
+	if id, ok := intValue(obj["id"]); ok && id == 0 {
+		delete(obj, "id")
+	}
+
+	c := s.collection(path, ids)
+	if c == nil {
+		return 0, fmt.Errorf("wctest: parent of %s does not exist", collectionKey(path, ids))
+	}
+
+	created, apiErr := s.create(s.configs[path], c, ids, obj)
+	if apiErr != nil {
+		return 0, fmt.Errorf("wctest: %s: %s", apiErr.code, apiErr.message)
+	}
+
+	id, _ := intValue(created["id"])
+	return id, nil
+}
+
+// Get unmarshals the object of the resource with the given ID into v.
+// It returns false if the object does not exist.
+func (s *Server) Get(resource Resource, id int, v interface{}) bool {
+	return s.get(string(resource), nil, id, v)
+}
+
+// GetVariation unmarshals the variation of the product into v.
+// It returns false if the variation does not exist.
+func (s *Server) GetVariation(productID, id int, v interface{}) bool {
+	return s.get(pathVariations, []int{productID}, id, v)
+}
+
+func (s *Server) get(path string, ids []int, id int, v interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collections[collectionKey(path, ids)]
+	if c == nil || c.objects[id] == nil {
+		return false
+	}
+
+	data, err := json.Marshal(c.objects[id])
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+func toObject(v interface{}) (object, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("wctest: could not marshal object: %w", err)
+	}
+
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("wctest: object must marshal to a JSON object: %w", err)
+	}
+	return obj, nil
+}
+
+// slugify converts the name to a slug the way WordPress does for simple names.
+func slugify(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// setDefault sets the field of the object if it is missing or empty.
+func setDefault(obj object, key string, value interface{}) {
+	if v, ok := obj[key]; !ok || v == nil || v == "" {
+		obj[key] = value
+	}
+}
+
+// findProduct finds a product or a product variation by its ID.
+func (s *Server) findProduct(id int) object {
+	if c := s.collections[string(ResourceProducts)]; c != nil && c.objects[id] != nil {
+		return c.objects[id]
+	}
+	for key, c := range s.collections {
+		if strings.HasPrefix(key, "products/") && strings.HasSuffix(key, "/variations") && c.objects[id] != nil {
+			return c.objects[id]
+		}
+	}
+	return nil
+}
+
+func prepareOrder(s *Server, ids []int, obj, existing object) *apiError {
+	if existing == nil {
+		id, _ := intValue(obj["id"])
+		setDefault(obj, "status", "pending")
+		setDefault(obj, "currency", "USD")
+		setDefault(obj, "created_via", "rest-api")
+		setDefault(obj, "parent_id", 0)
+		setDefault(obj, "customer_id", 0)
+		setDefault(obj, "meta_data", []interface{}{})
+		setDefault(obj, "refunds", []interface{}{})
+		obj["number"] = strconv.Itoa(id)
+		obj["order_key"] = "wc_order_" + randomToken()[:13]
+	}
+
+	if paid, _ := obj["set_paid"].(bool); paid {
+		local, gmt := s.now()
+		obj["status"] = "processing"
+		obj["date_paid"], obj["date_paid_gmt"] = local, gmt
+	}
+	delete(obj, "set_paid")
+
+	// Merge lines by their IDs. Lines are removed by setting their identifying field to null.
+	lines := []struct {
+		key, nullField string
+	}{
+		{"line_items", "product_id"},
+		{"shipping_lines", "method_id"},
+		{"fee_lines", "name"},
+		{"coupon_lines", "code"},
+	}
+	for _, l := range lines {
+		var existingLines interface{}
+		if existing != nil {
+			existingLines = existing[l.key]
+		}
+		obj[l.key] = mergeLines(s, existingLines, obj[l.key], l.nullField)
+	}
+
+	// Compute line item and order totals.
+	total, shippingTotal := 0.0, 0.0
+	for _, v := range obj["line_items"].([]interface{}) {
+		item := v.(map[string]interface{})
+		productID, _ := intValue(item["product_id"])
+		if variationID, _ := intValue(item["variation_id"]); variationID != 0 {
+			productID = variationID
+		}
+
+		product := s.findProduct(productID)
+		if product == nil {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_invalid_product_id", "Product ID provided is invalid."}
+		}
+
+		quantity, _ := intValue(item["quantity"])
+		price, _ := floatValue(product["price"])
+		lineTotal := price * float64(quantity)
+		if t, ok := floatValue(item["total"]); ok {
+			lineTotal = t
+		}
+
+		setDefault(item, "name", product["name"])
+		setDefault(item, "sku", product["sku"])
+		item["price"] = price
+		item["subtotal"] = formatPrice(lineTotal)
+		item["total"] = formatPrice(lineTotal)
+		setDefault(item, "subtotal_tax", "0.00")
+		setDefault(item, "total_tax", "0.00")
+		total += lineTotal
+	}
+	for _, v := range obj["shipping_lines"].([]interface{}) {
+		t, _ := floatValue(v.(map[string]interface{})["total"])
+		shippingTotal += t
+	}
+	for _, v := range obj["fee_lines"].([]interface{}) {
+		t, _ := floatValue(v.(map[string]interface{})["total"])
+		total += t
+	}
+
+	obj["shipping_total"] = formatPrice(shippingTotal)
+	obj["total"] = formatPrice(total + shippingTotal)
+	setDefault(obj, "discount_total", "0.00")
+	setDefault(obj, "total_tax", "0.00")
+	return nil
+}
+
+// mergeLines merges posted order lines into existing lines.
+// Lines with an ID update existing lines, lines without an ID are added
+// and lines with the null field set to null are removed.
+func mergeLines(s *Server, existing, posted interface{}, nullField string) []interface{} {
+	var result []interface{}
+	existingLines, _ := existing.([]interface{})
+	for _, v := range existingLines {
+		line := map[string]interface{}{}
+		for key, value := range v.(map[string]interface{}) {
+			line[key] = value
+		}
+		result = append(result, line)
+	}
+
+	postedLines, _ := posted.([]interface{})
+	for _, v := range postedLines {
+		p, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := intValue(p["id"])
+		found := -1
+		for i, line := range result {
+			if lineID, _ := intValue(line.(map[string]interface{})["id"]); id != 0 && lineID == id {
+				found = i
+				break
+			}
+		}
+
+		if value, ok := p[nullField]; ok && value == nil {
+			if found >= 0 {
+				result = append(result[:found], result[found+1:]...)
+			}
+			continue
+		}
+
+		if found >= 0 {
+			line := result[found].(map[string]interface{})
+			for key, value := range p {
+				line[key] = value
+			}
+			continue
+		}
+
+		line := map[string]interface{}{}
+		for key, value := range p {
+			line[key] = value
+		}
+		line["id"] = s.newID()
+		setDefault(line, "meta_data", []interface{}{})
+		result = append(result, line)
+	}
+
+	if result == nil {
+		result = []interface{}{}
+	}
+	return result
+}
+
+func matchOrder(s *Server, obj object, query url.Values) bool {
+	product := query.Get("product")
+	if product == "" {
+		return true
+	}
+
+	items, _ := obj["line_items"].([]interface{})
+	for _, v := range items {
+		if containsValue(product, fmt.Sprint(v.(map[string]interface{})["product_id"])) {
+			return true
+		}
+	}
+	return false
+}
+
+func prepareProduct(s *Server, ids []int, obj, existing object) *apiError {
+	if existing == nil {
+		setDefault(obj, "type", "simple")
+		setDefault(obj, "status", "publish")
+		setDefault(obj, "variations", []interface{}{})
+		setDefault(obj, "meta_data", []interface{}{})
+		if name, ok := obj["name"].(string); ok {
+			setDefault(obj, "slug", slugify(name))
+		}
+	}
+
+	preparePrice(obj, existing)
+	prepareStock(obj)
+	return nil
+}
+
+func matchProduct(s *Server, obj object, query url.Values) bool {
+	for param, field := range map[string]string{"category": "categories", "tag": "tags"} {
+		if v := query.Get(param); v != "" && !hasTerm(obj, field, v) {
+			return false
+		}
+	}
+
+	if v := query.Get("on_sale"); v != "" && fmt.Sprint(obj["on_sale"]) != v {
+		return false
+	}
+
+	price, _ := floatValue(obj["price"])
+	if v := query.Get("min_price"); v != "" {
+		if minPrice, err := strconv.ParseFloat(v, 64); err == nil && price < minPrice {
+			return false
+		}
+	}
+	if v := query.Get("max_price"); v != "" {
+		if maxPrice, err := strconv.ParseFloat(v, 64); err == nil && price > maxPrice {
+			return false
+		}
+	}
+	return true
+}
+
+// hasTerm checks if any of the terms in the field of the product has one of the comma separated ids.
+func hasTerm(obj object, field, ids string) bool {
+	terms, _ := obj[field].([]interface{})
+	for _, v := range terms {
+		if t, ok := v.(map[string]interface{}); ok && containsValue(ids, fmt.Sprint(t["id"])) {
+			return true
+		}
+	}
+	return false
+}
+
+func prepareVariation(s *Server, ids []int, obj, existing object) *apiError {
+	if existing == nil {
+		setDefault(obj, "status", "publish")
+		setDefault(obj, "meta_data", []interface{}{})
+		setDefault(obj, "attributes", []interface{}{})
+
+		// Add the variation to its parent.
+		parent := s.collections[string(ResourceProducts)].objects[ids[0]]
+		variations, _ := parent["variations"].([]interface{})
+		parent["variations"] = append(variations, obj["id"])
+	}
+	obj["parent_id"] = ids[0]
+
+	// Woocommerce reports variations that do not manage stock as managed by the parent if the parent manages stock.
+	parent := s.collections[string(ResourceProducts)].objects[ids[0]]
+	if manage, _ := obj["manage_stock"].(bool); !manage {
+		if parentManage, _ := parent["manage_stock"].(bool); parentManage {
+			obj["manage_stock"] = "parent"
+		} else {
+			obj["manage_stock"] = false
+		}
+	}
+
+	preparePrice(obj, existing)
+	prepareStock(obj)
+	return nil
+}
+
+func variationDeleted(s *Server, ids []int, obj object) {
+	parent := s.collections[string(ResourceProducts)].objects[ids[0]]
+	if parent == nil {
+		return
+	}
+
+	var variations []interface{}
+	existing, _ := parent["variations"].([]interface{})
+	for _, v := range existing {
+		if id, _ := intValue(v); id != ids[1] {
+			variations = append(variations, v)
+		}
+	}
+	if variations == nil {
+		variations = []interface{}{}
+	}
+	parent["variations"] = variations
+}
+
+// preparePrice computes the active price of the product from its regular and sale prices.
+func preparePrice(obj, existing object) {
+	// Woocommerce ignores prices that are null.
+	for _, key := range []string{"regular_price", "sale_price"} {
+		if value, ok := obj[key]; ok && value == nil {
+			obj[key] = existing[key]
+			setDefault(obj, key, "")
+		}
+	}
+
+	regular, _ := obj["regular_price"].(string)
+	sale, _ := obj["sale_price"].(string)
+
+	obj["on_sale"] = sale != ""
+	if sale != "" {
+		obj["price"] = sale
+	} else {
+		obj["price"] = regular
+	}
+}
+
+// prepareStock computes stock status of products that manage stock.
+func prepareStock(obj object) {
+	if manage, _ := obj["manage_stock"].(bool); !manage {
+		setDefault(obj, "stock_status", "instock")
+		return
+	}
+
+	quantity, _ := intValue(obj["stock_quantity"])
+	if quantity > 0 {
+		obj["stock_status"] = "instock"
+	} else {
+		obj["stock_status"] = "outofstock"
+	}
+}
+
+func prepareCustomer(s *Server, ids []int, obj, existing object) *apiError {
+	email, _ := obj["email"].(string)
+	if email == "" {
+		return &apiError{http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): email"}
+	}
+
+	// Email must be unique.
+	for id, c := range s.collections[string(ResourceCustomers)].objects {
+		if objID, _ := intValue(obj["id"]); id != objID && strings.EqualFold(fmt.Sprint(c["email"]), email) {
+			return &apiError{http.StatusBadRequest, "registration-error-email-exists", "An account is already registered with your email address."}
+		}
+	}
+
+	if existing == nil {
+		// Usernames must be unique as well. Woocommerce generates them from emails when they are missing.
+		if username, _ := obj["username"].(string); username != "" {
+			for _, c := range s.collections[string(ResourceCustomers)].objects {
+				if strings.EqualFold(fmt.Sprint(c["username"]), username) {
+					return &apiError{http.StatusBadRequest, "registration-error-username-exists", "An account is already registered with that username. Please choose another."}
+				}
+			}
+		}
+
+		setDefault(obj, "role", "customer")
+		setDefault(obj, "username", strings.Split(email, "@")[0])
+		setDefault(obj, "is_paying_customer", false)
+		setDefault(obj, "meta_data", []interface{}{})
+	}
+
+	// Passwords are never returned.
+	delete(obj, "password")
+	return nil
+}
+
+func matchCustomer(s *Server, obj object, query url.Values) bool {
+	role := query.Get("role")
+	if role == "" {
+		role = "customer"
+	}
+	return role == "all" || containsValue(role, fmt.Sprint(obj["role"]))
+}
+
+func prepareTax(s *Server, ids []int, obj, existing object) *apiError {
+	setDefault(obj, "class", "standard")
+	if s.taxClass(fmt.Sprint(obj["class"])) < 0 {
+		return &apiError{http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): class"}
+	}
+	setDefault(obj, "priority", 1)
+	setDefault(obj, "order", 0)
+	setDefault(obj, "postcodes", []interface{}{})
+	setDefault(obj, "cities", []interface{}{})
+	if _, ok := obj["shipping"]; !ok {
+		obj["shipping"] = true
+	}
+	if _, ok := obj["compound"]; !ok {
+		obj["compound"] = false
+	}
+
+	rate, _ := floatValue(obj["rate"])
+	obj["rate"] = strconv.FormatFloat(rate, 'f', 4, 64)
+	return nil
+}
+
+// prepareCoupon validates the code of the coupon, which is case-insensitive and unique, and fills defaults.
+func prepareCoupon(s *Server, ids []int, obj, existing object) *apiError {
+	code := strings.ToLower(strings.TrimSpace(fmt.Sprint(obj["code"])))
+	if obj["code"] == nil || code == "" {
+		return &apiError{http.StatusBadRequest, "woocommerce_rest_empty_coupon_code", "The coupon code cannot be empty."}
+	}
+	for id, c := range s.collections[string(ResourceCoupons)].objects {
+		if objID, _ := intValue(obj["id"]); id != objID && c["code"] == code {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_coupon_code_already_exists", "The coupon code already exists"}
+		}
+	}
+	obj["code"] = code
+
+	if existing == nil {
+		setDefault(obj, "discount_type", "fixed_cart")
+		setDefault(obj, "amount", "0")
+		setDefault(obj, "usage_count", 0)
+		setDefault(obj, "status", "publish")
+		setDefault(obj, "meta_data", []interface{}{})
+		setDefault(obj, "used_by", []interface{}{})
+	}
+	if amount, ok := floatValue(obj["amount"]); ok {
+		obj["amount"] = formatPrice(amount)
+	}
+	return nil
+}
+
+// matchCoupon filters coupons by their code. Coupon codes are case-insensitive.
+func matchCoupon(s *Server, obj object, query url.Values) bool {
+	if code := query.Get("code"); code != "" {
+		return strings.EqualFold(fmt.Sprint(obj["code"]), code)
+	}
+	return true
+}
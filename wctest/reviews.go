@@ -0,0 +1,76 @@
+package wctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const pathReviews = "products/reviews"
+
+// registerReviews registers product reviews.
+func (s *Server) registerReviews() {
+	cfg := collectionConfig{
+		path:          pathReviews,
+		trash:         true,
+		customStatus:  true,
+		defaultOrder:  "desc",
+		invalidIDCode: "woocommerce_rest_review_invalid_id",
+		filters: map[string]string{
+			"product":        "product_id",
+			"reviewer_email": "reviewer_email",
+		},
+		match:   matchReview,
+		prepare: prepareReview,
+	}
+	s.configs[cfg.path] = cfg
+	s.registerCollection(cfg)
+}
+
+func prepareReview(s *Server, ids []int, obj, existing object) *apiError {
+	productID, _ := intValue(obj["product_id"])
+	product := s.findProduct(productID)
+	if product == nil {
+		return &apiError{http.StatusNotFound, "woocommerce_rest_product_invalid_id", "Invalid product ID."}
+	}
+	for _, field := range []string{"review", "reviewer", "reviewer_email"} {
+		if v, _ := obj[field].(string); v == "" {
+			return &apiError{http.StatusBadRequest, "rest_missing_callback_param", fmt.Sprintf("Missing parameter(s): %s", field)}
+		}
+	}
+	if rating, _ := intValue(obj["rating"]); rating < 0 || rating > 5 {
+		return &apiError{http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): rating"}
+	}
+
+	// Restored reviews are approved. Woocommerce restores the status that the review had before.
+	switch obj["status"] {
+	case nil, "", "unspam", "untrash":
+		obj["status"] = "approved"
+	case "approved", "hold", "spam", "trash":
+	default:
+		return &apiError{http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): status"}
+	}
+
+	obj["product_name"] = product["name"]
+	obj["product_permalink"] = product["permalink"]
+	setDefault(obj, "rating", 0)
+	setDefault(obj, "verified", false)
+	setDefault(obj, "reviewer_avatar_urls", map[string]interface{}{})
+	delete(obj, "date_modified")
+	delete(obj, "date_modified_gmt")
+	return nil
+}
+
+// matchReview filters reviews by their status. Approved reviews are listed by default
+// and all lists approved reviews and reviews on hold.
+func matchReview(s *Server, obj object, query url.Values) bool {
+	status := query.Get("status")
+	switch status {
+	case "":
+		return obj["status"] == "approved"
+	case "all":
+		return obj["status"] == "approved" || obj["status"] == "hold"
+	default:
+		return containsValue(status, fmt.Sprint(obj["status"]))
+	}
+}
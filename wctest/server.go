@@ -0,0 +1,214 @@
+// Package wctest provides an in-memory fake woocommerce server for tests.
+//
+// The server implements the parts of the REST API and the Store API that are used by this library,
+// so clients can be tested without a live store:
+//
+//	srv := wctest.NewServer()
+//	defer srv.Close()
+//
+//	api := client.New[woocommerce.Customer, woocommerce.Product, woocommerce.ProductVariation](
+//		srv.URL, srv.ConsumerKey, srv.ConsumerSecret)
+//
+// The server only mimics the behaviour of woocommerce. Objects are stored as they are sent,
+// with ids, dates and a few computed fields added by the server.
+package wctest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	pathPrefixRest   = "/wp-json/wc/v3"
+	pathPrefixBlocks = "/wp-json/wc/store/v1"
+
+	timeFormat = "2006-01-02T15:04:05"
+)
+
+// Server is a fake woocommerce server. It should be created with the NewServer function.
+type Server struct {
+	*httptest.Server
+
+	// ConsumerKey and ConsumerSecret are credentials that the server accepts.
+	ConsumerKey    string
+	ConsumerSecret string
+
+	// Now returns the current time of the server. It is used for dates of created and modified objects.
+	Now func() time.Time
+
+	mu          sync.Mutex
+	nextID      int
+	routes      []*route
+	configs     map[string]collectionConfig
+	collections map[string]*collection
+	carts       map[string]*cart
+	taxClasses  []object
+}
+
+// NewServer creates and starts a new fake woocommerce server.
+// The server should be closed with the Close method when it is not needed anymore.
+func NewServer() *Server {
+	s := &Server{
+		ConsumerKey:    "ck_" + randomToken(),
+		ConsumerSecret: "cs_" + randomToken(),
+		Now:            time.Now,
+		nextID:         1,
+		collections:    make(map[string]*collection),
+		carts:          make(map[string]*cart),
+	}
+	s.registerRoutes()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, pathPrefixRest+"/"):
+		if !s.authenticated(r) {
+			writeError(w, http.StatusUnauthorized, "woocommerce_rest_cannot_view", "Sorry, you cannot list resources.")
+			return
+		}
+		s.serveRest(w, r, strings.TrimPrefix(r.URL.Path, pathPrefixRest+"/"))
+	case strings.HasPrefix(r.URL.Path, pathPrefixBlocks+"/"):
+		s.serveStore(w, r, strings.TrimPrefix(r.URL.Path, pathPrefixBlocks+"/"))
+	default:
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+	}
+}
+
+// authenticated checks the credentials of the request. HTTP Basic authentication, query string authentication
+// and OAuth 1.0a are accepted. OAuth signatures are not verified, only the consumer key is checked.
+func (s *Server) authenticated(r *http.Request) bool {
+	if key, secret, ok := r.BasicAuth(); ok {
+		return key == s.ConsumerKey && secret == s.ConsumerSecret
+	}
+
+	query := r.URL.Query()
+	if query.Get("consumer_key") != "" {
+		return query.Get("consumer_key") == s.ConsumerKey && query.Get("consumer_secret") == s.ConsumerSecret
+	}
+	if query.Get("oauth_signature") != "" {
+		return query.Get("oauth_consumer_key") == s.ConsumerKey
+	}
+
+	return false
+}
+
+// route is a REST API route. Segments of the pattern in braces match numeric ids,
+// which are passed to the handler. The {slug} segment matches any value and is not passed to the handler.
+type route struct {
+	pattern []string
+	methods map[string]func(w http.ResponseWriter, r *http.Request, ids []int)
+}
+
+func (s *Server) handle(pattern, method string, handler func(w http.ResponseWriter, r *http.Request, ids []int)) {
+	segments := strings.Split(pattern, "/")
+	for _, rt := range s.routes {
+		if strings.Join(rt.pattern, "/") == pattern {
+			rt.methods[method] = handler
+			return
+		}
+	}
+
+	s.routes = append(s.routes, &route{
+		pattern: segments,
+		methods: map[string]func(w http.ResponseWriter, r *http.Request, ids []int){method: handler},
+	})
+}
+
+func (s *Server) serveRest(w http.ResponseWriter, r *http.Request, path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, rt := range s.routes {
+		ids, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+
+		handler, ok := rt.methods[r.Method]
+		if !ok && r.Method == http.MethodPatch {
+			handler, ok = rt.methods[http.MethodPut]
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+			return
+		}
+
+		handler(w, r, ids)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+}
+
+func (rt *route) match(segments []string) ([]int, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+
+	var ids []int
+	for i, p := range rt.pattern {
+		if p == "{slug}" {
+			continue
+		}
+		if strings.HasPrefix(p, "{") {
+			id, err := strconv.Atoi(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			ids = append(ids, id)
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+	return ids, true
+}
+
+// newID returns the next object ID. IDs are shared between all collections.
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) now() (local, gmt string) {
+	now := s.Now()
+	return now.Format(timeFormat), now.UTC().Format(timeFormat)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorObject(status, code, message))
+}
+
+func errorObject(status int, code, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"code":    code,
+		"message": message,
+		"data": map[string]interface{}{
+			"status": status,
+		},
+	}
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,145 @@
+package wctest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zerodays/woocommerce-go"
+	"github.com/zerodays/woocommerce-go/client"
+	"github.com/zerodays/woocommerce-go/wctest"
+)
+
+func newAPI(t *testing.T) (*wctest.Server, *client.API[woocommerce.Customer, woocommerce.Product, woocommerce.ProductVariation]) {
+	srv := wctest.NewServer()
+	t.Cleanup(srv.Close)
+
+	api := client.New[woocommerce.Customer, woocommerce.Product, woocommerce.ProductVariation](
+		srv.URL, srv.ConsumerKey, srv.ConsumerSecret, client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}))
+	return srv, api
+}
+
+func TestServer_Authentication(t *testing.T) {
+	srv := wctest.NewServer()
+	defer srv.Close()
+
+	api := client.New[woocommerce.Customer, woocommerce.Product, woocommerce.ProductVariation](
+		srv.URL, srv.ConsumerKey, "wrong", client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}))
+	_, _, err := api.Order.List(nil)
+
+	var wcErr *woocommerce.Error
+	if !errors.As(err, &wcErr) || wcErr.Data.Status != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	srv, api := newAPI(t)
+	for i := 0; i < 25; i++ {
+		if _, err := srv.Add(wctest.ResourceOrders, map[string]interface{}{"customer_note": fmt.Sprintf("Order %d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	orders, total, err := api.Order.List(woocommerce.PageParams{Page: 3, PerPage: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 25 {
+		t.Errorf("expected total 25, got %d", total)
+	}
+	if len(orders) != 5 {
+		t.Errorf("expected 5 orders on the last page, got %d", len(orders))
+	}
+
+	// Raw parameters are not validated by the client, so the request reaches the server.
+	_, _, err = api.Order.List(woocommerce.BaseParameters{"per_page": {"101"}})
+	var wcErr *woocommerce.Error
+	if !errors.As(err, &wcErr) || wcErr.Code != "rest_invalid_param" {
+		t.Errorf("expected rest_invalid_param error, got %v", err)
+	}
+}
+
+func TestServer_Batch(t *testing.T) {
+	srv, _ := newAPI(t)
+	id, err := srv.Add(wctest.ResourceTaxes, map[string]interface{}{"country": "SI", "rate": "22.0000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := fmt.Sprintf(`{"create":[{"country":"AT","rate":"20.0000"}],"update":[{"id":%d,"rate":"9.5000"}],"delete":[99999]}`, id)
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/wp-json/wc/v3/taxes/batch", strings.NewReader(body))
+	req.SetBasicAuth(srv.ConsumerKey, srv.ConsumerSecret)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Create []map[string]interface{} `json:"create"`
+		Update []map[string]interface{} `json:"update"`
+		Delete []map[string]interface{} `json:"delete"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Create) != 1 || result.Create[0]["country"] != "AT" {
+		t.Errorf("unexpected create result: %v", result.Create)
+	}
+	if len(result.Update) != 1 || result.Update[0]["rate"] != "9.5000" {
+		t.Errorf("unexpected update result: %v", result.Update)
+	}
+	if len(result.Delete) != 1 || result.Delete[0]["error"] == nil {
+		t.Errorf("expected an error for deleting a missing tax, got %v", result.Delete)
+	}
+}
+
+func TestServer_Cart(t *testing.T) {
+	srv, api := newAPI(t)
+	productID, err := srv.Add(wctest.ResourceProducts, map[string]interface{}{"name": "Shirt", "regular_price": "12.50"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := api.Cart.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == "" {
+		t.Fatal("expected a cart token")
+	}
+
+	cart, err := api.Cart.AddItem(token, productID, 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cart.Items) != 1 || cart.Totals.TotalPrice != 2500 {
+		t.Fatalf("unexpected cart: %+v", cart)
+	}
+
+	cart, err = api.Cart.UpdateItem(token, cart.Items[0].Key, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cart.Totals.TotalPrice != 1250 {
+		t.Errorf("expected total price 1250, got %d", cart.Totals.TotalPrice)
+	}
+
+	// Requests that modify the cart without a nonce are rejected.
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/wp-json/wc/store/v1/cart/add-item", strings.NewReader(`{"id":1}`))
+	req.Header.Set("Cart-Token", token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status %d without nonce, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+}
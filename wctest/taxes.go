@@ -0,0 +1,79 @@
+package wctest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const pathTaxClasses = "taxes/classes"
+
+// registerTaxClasses registers tax classes. Classes are identified by their slugs, so they are not
+// stored in a collection. The standard class always exists, as in woocommerce.
+func (s *Server) registerTaxClasses() {
+	s.taxClasses = []object{
+		{"slug": "standard", "name": "Standard rate"},
+		{"slug": "reduced-rate", "name": "Reduced rate"},
+		{"slug": "zero-rate", "name": "Zero rate"},
+	}
+
+	s.handle(pathTaxClasses, http.MethodGet, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		writeJSON(w, http.StatusOK, s.taxClasses)
+	})
+	s.handle(pathTaxClasses, http.MethodPost, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		var obj object
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+			return
+		}
+		name, _ := obj["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			writeError(w, http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): name")
+			return
+		}
+
+		slug := slugify(name)
+		if s.taxClass(slug) >= 0 {
+			writeError(w, http.StatusBadRequest, "woocommerce_rest_tax_class_exists", "Tax class already exists")
+			return
+		}
+		class := object{"slug": slug, "name": name}
+		s.taxClasses = append(s.taxClasses, class)
+		writeJSON(w, http.StatusCreated, class)
+	})
+	s.handle(pathTaxClasses+"/{slug}", http.MethodDelete, func(w http.ResponseWriter, r *http.Request, ids []int) {
+		if force := r.URL.Query().Get("force"); force != "true" && force != "1" {
+			writeError(w, http.StatusNotImplemented, "woocommerce_rest_trash_not_supported", "Taxes do not support trashing.")
+			return
+		}
+
+		slug := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		i := s.taxClass(slug)
+		if i <= 0 {
+			writeError(w, http.StatusNotFound, "woocommerce_rest_tax_class_invalid_slug", "Invalid slug.")
+			return
+		}
+		class := s.taxClasses[i]
+		s.taxClasses = append(s.taxClasses[:i], s.taxClasses[i+1:]...)
+
+		// Rates of the class are deleted with it.
+		taxes := s.collection(string(ResourceTaxes), nil)
+		for id, tax := range taxes.objects {
+			if tax["class"] == slug {
+				delete(taxes.objects, id)
+			}
+		}
+		writeJSON(w, http.StatusOK, class)
+	})
+}
+
+// taxClass returns the index of the tax class with the slug, or -1 if there is no such class.
+// The standard class has index 0.
+func (s *Server) taxClass(slug string) int {
+	for i, class := range s.taxClasses {
+		if class["slug"] == slug {
+			return i
+		}
+	}
+	return -1
+}
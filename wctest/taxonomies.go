@@ -0,0 +1,114 @@
+package wctest
+
+import (
+	"net/http"
+)
+
+const (
+	pathCategories      = "products/categories"
+	pathTags            = "products/tags"
+	pathShippingClasses = "products/shipping_classes"
+)
+
+// registerTaxonomies registers product categories, tags and shipping classes.
+func (s *Server) registerTaxonomies() {
+	configs := []collectionConfig{
+		{
+			path:          pathCategories,
+			defaultOrder:  "asc",
+			invalidIDCode: "woocommerce_rest_term_invalid",
+			filters: map[string]string{
+				"slug":   "slug",
+				"parent": "parent",
+			},
+			prepare: prepareCategory,
+			deleted: categoryDeleted,
+		},
+		{
+			path:          pathTags,
+			defaultOrder:  "asc",
+			invalidIDCode: "woocommerce_rest_term_invalid",
+			filters: map[string]string{
+				"slug": "slug",
+			},
+			prepare: func(s *Server, ids []int, obj, existing object) *apiError {
+				return prepareTaxonomyTerm(s, pathTags, obj)
+			},
+		},
+		{
+			path:          pathShippingClasses,
+			defaultOrder:  "asc",
+			invalidIDCode: "woocommerce_rest_term_invalid",
+			filters: map[string]string{
+				"slug": "slug",
+			},
+			prepare: func(s *Server, ids []int, obj, existing object) *apiError {
+				return prepareTaxonomyTerm(s, pathShippingClasses, obj)
+			},
+		},
+	}
+	for _, cfg := range configs {
+		s.configs[cfg.path] = cfg
+		s.registerCollection(cfg)
+	}
+}
+
+// prepareTaxonomyTerm validates the name and the slug of a term stored in the collection with the given key.
+func prepareTaxonomyTerm(s *Server, key string, obj object) *apiError {
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return &apiError{http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): name"}
+	}
+
+	slug, _ := obj["slug"].(string)
+	if slug == "" {
+		slug = slugify(name)
+	}
+	objID, _ := intValue(obj["id"])
+	for id, t := range s.collections[key].objects {
+		if id != objID && t["slug"] == slug {
+			return &apiError{http.StatusBadRequest, "term_exists", "A term with the name provided already exists with this parent."}
+		}
+	}
+	obj["slug"] = slug
+
+	setDefault(obj, "description", "")
+	setDefault(obj, "count", 0)
+	deleteDates(obj)
+	return nil
+}
+
+func prepareCategory(s *Server, ids []int, obj, existing object) *apiError {
+	if err := prepareTaxonomyTerm(s, pathCategories, obj); err != nil {
+		return err
+	}
+
+	// The parent must exist and can not be the category itself or one of its descendants.
+	categories := s.collections[pathCategories].objects
+	objID, _ := intValue(obj["id"])
+	parent, _ := intValue(obj["parent"])
+	for p := parent; p != 0; {
+		if p == objID || categories[p] == nil {
+			return &apiError{http.StatusBadRequest, "woocommerce_rest_term_invalid", "Parent term does not exist."}
+		}
+		p, _ = intValue(categories[p]["parent"])
+	}
+	obj["parent"] = parent
+
+	setDefault(obj, "display", "default")
+	setDefault(obj, "menu_order", 0)
+	if _, ok := obj["image"]; !ok {
+		obj["image"] = nil
+	}
+	return nil
+}
+
+// categoryDeleted moves children of the deleted category to its parent.
+func categoryDeleted(s *Server, ids []int, obj object) {
+	id, _ := intValue(obj["id"])
+	for _, c := range s.collections[pathCategories].objects {
+		if parent, _ := intValue(c["parent"]); parent == id {
+			c["parent"] = obj["parent"]
+		}
+	}
+}